@@ -0,0 +1,128 @@
+package goyfinance
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// TickerError is the error of a single ticker of a batch.
+type TickerError struct {
+	Index  int // Position of the ticker in the tickers slice
+	Ticker string
+	Err    error
+}
+
+func (e *TickerError) Error() string {
+	return e.Ticker + ": " + e.Err.Error()
+}
+
+func (e *TickerError) Unwrap() error {
+	return e.Err
+}
+
+// BatchError is returned by the batch functions when one or more tickers failed.
+// Errors holds one TickerError per failed ticker, in the order of the tickers slice.
+// It works with errors.Is and errors.As through the errors of the tickers,
+// so errors.Is(err, context.Canceled) tells whether the batch was canceled.
+type BatchError struct {
+	Errors []*TickerError
+}
+
+func (e *BatchError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "goyfinance: %d tickers failed: ", len(e.Errors))
+	for i, tickerErr := range e.Errors {
+		if i > 0 {
+			b.WriteString("; ")
+		}
+		b.WriteString(tickerErr.Error())
+	}
+	return b.String()
+}
+
+func (e *BatchError) Unwrap() []error {
+	errs := make([]error, len(e.Errors))
+	for i, tickerErr := range e.Errors {
+		errs[i] = tickerErr
+	}
+	return errs
+}
+
+// Tickers returns the failed tickers, in the order of the tickers slice.
+func (e *BatchError) Tickers() []string {
+	tickers := make([]string, len(e.Errors))
+	for i, tickerErr := range e.Errors {
+		tickers[i] = tickerErr.Ticker
+	}
+	return tickers
+}
+
+// Err returns the error of the ticker at index in the tickers slice,
+// or nil if that ticker succeeded.
+func (e *BatchError) Err(index int) error {
+	for _, tickerErr := range e.Errors {
+		if tickerErr.Index == index {
+			return tickerErr.Err
+		}
+	}
+	return nil
+}
+
+// runBatch calls fetch for every ticker,
+// with at most maxConcurrency calls at the same time if it is positive.
+// The results are in the order of tickers, failed tickers are left as the zero value.
+// The error is nil if every ticker succeeded and a *BatchError otherwise.
+func runBatch[T any](ctx context.Context, maxConcurrency int, tickers []string, fetch func(ctx context.Context, ticker string) (T, error)) ([]T, error) {
+	res := make([]T, len(tickers))
+	errs := make([]error, len(tickers))
+
+	forEach(len(tickers), maxConcurrency, func(i int) {
+		res[i], errs[i] = fetch(ctx, tickers[i])
+	})
+
+	return res, newBatchError(tickers, errs)
+}
+
+// newBatchError returns a *BatchError with the errors of tickers,
+// errs holding the error of every ticker or nil if it succeeded,
+// or nil if every ticker succeeded.
+func newBatchError(tickers []string, errs []error) error {
+	var batchErr BatchError
+	for i, err := range errs {
+		if err != nil {
+			batchErr.Errors = append(batchErr.Errors, &TickerError{Index: i, Ticker: tickers[i], Err: err})
+		}
+	}
+	if len(batchErr.Errors) > 0 {
+		return &batchErr
+	}
+	return nil
+}
+
+// forEach calls f for every index from 0 to n-1,
+// with at most maxConcurrency calls at the same time if it is positive,
+// and returns once every call returned.
+func forEach(n int, maxConcurrency int, f func(i int)) {
+	workers := n
+	if maxConcurrency > 0 && maxConcurrency < workers {
+		workers = maxConcurrency
+	}
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				f(i)
+			}
+		}()
+	}
+	for i := 0; i < n; i++ {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+}
@@ -0,0 +1,95 @@
+package goyfinance
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// splitRange splits period into ranges Yahoo serves in a single request for interval.
+// It returns nil if period does not need to be split.
+func splitRange(interval Interval, period Range, now time.Time) []DateRange {
+	limit := intervalLimits[interval]
+	if limit.maxSpan <= 0 {
+		return nil
+	}
+	period1, period2, err := period.timestamps(now)
+	if err != nil || time.Unix(period2, 0).Sub(time.Unix(period1, 0)) <= limit.maxSpan {
+		return nil
+	}
+
+	var chunks []DateRange
+	for start := time.Unix(period1, 0); start.Before(time.Unix(period2, 0)); {
+		end := start.Add(limit.maxSpan)
+		if end.After(time.Unix(period2, 0)) {
+			end = time.Unix(period2, 0)
+		}
+		chunks = append(chunks, DateRange{Start: start, End: end})
+		start = end
+	}
+	return chunks
+}
+
+// getQuoteChunks fetches every chunk concurrently
+// and merges them into a single Quote.
+// Inside a batch, the requests of the chunks share
+// the maximum concurrency of the client with the other tickers.
+func (c *Client) getQuoteChunks(ctx context.Context, ticker string, interval Interval, chunks []DateRange) (Quote, error) {
+	quotes := make([]Quote, len(chunks))
+	errs := make([]error, len(chunks))
+	forEach(len(chunks), c.maxConcurrency, func(i int) {
+		quotes[i], errs[i] = c.getQuote(ctx, ticker, interval, chunks[i], c.includePrePost)
+	})
+	for i, err := range errs {
+		if err != nil {
+			return Quote{}, fmt.Errorf("goyfinance: %s from %s to %s: %w", ticker, chunks[i].Start.Format(time.DateOnly), chunks[i].End.Format(time.DateOnly), err)
+		}
+	}
+
+	quote := quotes[len(quotes)-1]
+	quote.PriceRangeStart = chunks[0].Start.Unix()
+	quote.PriceRangeEnd = chunks[len(chunks)-1].End.Unix()
+	quote.PriceHistoric = nil
+	quote.Dividends = nil
+	quote.Splits = nil
+	quote.CapitalGains = nil
+	quote.Meta.TradingPeriods = nil
+	quote.Meta.PreMarketPeriods = nil
+	quote.Meta.PostMarketPeriods = nil
+	for _, chunk := range quotes {
+		quote.PriceHistoric = append(quote.PriceHistoric, chunk.PriceHistoric...)
+		quote.Dividends = append(quote.Dividends, chunk.Dividends...)
+		quote.Splits = append(quote.Splits, chunk.Splits...)
+		quote.CapitalGains = append(quote.CapitalGains, chunk.CapitalGains...)
+		quote.Meta.TradingPeriods = append(quote.Meta.TradingPeriods, chunk.Meta.TradingPeriods...)
+		quote.Meta.PreMarketPeriods = append(quote.Meta.PreMarketPeriods, chunk.Meta.PreMarketPeriods...)
+		quote.Meta.PostMarketPeriods = append(quote.Meta.PostMarketPeriods, chunk.Meta.PostMarketPeriods...)
+	}
+	quote.PriceHistoric = sortByTimestamp(quote.PriceHistoric, func(priceData PriceData) int64 { return priceData.Timestamp })
+	quote.Dividends = sortByTimestamp(quote.Dividends, func(dividend Dividend) int64 { return dividend.Timestamp })
+	quote.Splits = sortByTimestamp(quote.Splits, func(split Split) int64 { return split.Timestamp })
+	quote.CapitalGains = sortByTimestamp(quote.CapitalGains, func(capitalGain CapitalGain) int64 { return capitalGain.Timestamp })
+	periodStart := func(period TradingPeriod) int64 { return period.Start.Unix() }
+	quote.Meta.TradingPeriods = sortByTimestamp(quote.Meta.TradingPeriods, periodStart)
+	quote.Meta.PreMarketPeriods = sortByTimestamp(quote.Meta.PreMarketPeriods, periodStart)
+	quote.Meta.PostMarketPeriods = sortByTimestamp(quote.Meta.PostMarketPeriods, periodStart)
+	return quote, nil
+}
+
+// sortByTimestamp sorts values in chronological order
+// and removes the values with the same timestamp as the previous one,
+// which happen at the boundaries of chunks.
+func sortByTimestamp[T any](values []T, timestamp func(T) int64) []T {
+	sort.SliceStable(values, func(i, j int) bool {
+		return timestamp(values[i]) < timestamp(values[j])
+	})
+	deduplicated := values[:0]
+	for _, value := range values {
+		if len(deduplicated) > 0 && timestamp(value) == timestamp(deduplicated[len(deduplicated)-1]) {
+			continue
+		}
+		deduplicated = append(deduplicated, value)
+	}
+	return deduplicated
+}
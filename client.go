@@ -0,0 +1,274 @@
+package goyfinance
+
+import (
+	"context"
+	"errors"
+	"github.com/valyala/fasthttp"
+	"strings"
+	"time"
+)
+
+// DefaultBaseURL is the Yahoo Finance host used when no base URL is given.
+const DefaultBaseURL = "https://query1.finance.yahoo.com"
+
+// DefaultMaxConcurrency is the number of requests
+// a client sends at the same time when no limit is given.
+const DefaultMaxConcurrency = 10
+
+// DefaultUserAgent is the User-Agent sent when no User-Agent is given.
+// Yahoo rejects some requests without a browser-looking User-Agent.
+const DefaultUserAgent = "Mozilla/5.0 (X11; Linux x86_64; rv:86.0) Gecko/20100101 Firefox/86.0"
+
+// Client fetches data from Yahoo Finance.
+// It holds the settings shared by every request,
+// such as the base URL or the underlying fasthttp client.
+// A Client is safe for concurrent use and should be reused.
+// The zero value is not usable, create one with NewClient.
+type Client struct {
+	baseURL    string
+	cookieURL  string
+	userAgent  string
+	timeout    time.Duration
+	httpClient *fasthttp.Client
+	session    *crumbSession
+
+	maxConcurrency int
+	inFlight       semaphore
+	limiter        *rateLimiter
+	retryPolicy    RetryPolicy
+	missingBars    MissingBarPolicy
+	includePrePost bool
+}
+
+// Option configures a Client.
+type Option func(*Client)
+
+// WithBaseURL sets the base URL requests are sent to,
+// for example an internal mirror or a local test server.
+// It defaults to DefaultBaseURL.
+func WithBaseURL(baseURL string) Option {
+	return func(c *Client) {
+		c.baseURL = strings.TrimRight(baseURL, "/")
+	}
+}
+
+// WithCookieURL sets the page requested for the session cookie
+// sent along with the crumb to the endpoints requiring one.
+// It defaults to DefaultCookieURL.
+func WithCookieURL(cookieURL string) Option {
+	return func(c *Client) {
+		c.cookieURL = cookieURL
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request.
+// It defaults to DefaultUserAgent.
+func WithUserAgent(userAgent string) Option {
+	return func(c *Client) {
+		c.userAgent = userAgent
+	}
+}
+
+// WithTimeout sets the maximum duration of a single request.
+// A timeout of 0 means no timeout, which is the default.
+func WithTimeout(timeout time.Duration) Option {
+	return func(c *Client) {
+		c.timeout = timeout
+	}
+}
+
+// WithHTTPClient sets the fasthttp client used to send requests.
+// Use it to configure a proxy, TLS or the maximum number of connections.
+// It defaults to a zero fasthttp.Client, like fasthttp.Do.
+func WithHTTPClient(httpClient *fasthttp.Client) Option {
+	return func(c *Client) {
+		c.httpClient = httpClient
+	}
+}
+
+// WithMaxConcurrency sets the number of requests
+// the client sends at the same time, across every batch and call.
+// A value of 0 or less means no limit.
+// It defaults to DefaultMaxConcurrency.
+func WithMaxConcurrency(maxConcurrency int) Option {
+	return func(c *Client) {
+		c.maxConcurrency = maxConcurrency
+	}
+}
+
+// WithRateLimit limits every request of the client,
+// including batches and ContinuousPriceUpdater,
+// to requestsPerSecond on average with bursts of up to burst requests.
+// A requestsPerSecond of 0 or less means no limit, which is the default.
+func WithRateLimit(requestsPerSecond float64, burst int) Option {
+	return func(c *Client) {
+		c.limiter = newRateLimiter(requestsPerSecond, burst)
+	}
+}
+
+// WithMissingBars sets what GetQuote does with the bars
+// Yahoo sent no data for.
+// It defaults to MissingBarsKeep.
+func WithMissingBars(policy MissingBarPolicy) Option {
+	return func(c *Client) {
+		c.missingBars = policy
+	}
+}
+
+// WithPrePost sets whether the chart requests include
+// the pre-market and post-market bars of intraday intervals.
+// The session of each bar is in PriceData.Session.
+// It defaults to false, which only includes regular trading hours.
+func WithPrePost(includePrePost bool) Option {
+	return func(c *Client) {
+		c.includePrePost = includePrePost
+	}
+}
+
+// NewClient returns a Client configured with the given options.
+func NewClient(opts ...Option) *Client {
+	c := &Client{
+		baseURL:    DefaultBaseURL,
+		cookieURL:  DefaultCookieURL,
+		userAgent:  DefaultUserAgent,
+		httpClient: &fasthttp.Client{},
+		session:    newCrumbSession(),
+
+		maxConcurrency: DefaultMaxConcurrency,
+		retryPolicy:    DefaultRetryPolicy,
+		missingBars:    MissingBarsKeep,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	c.inFlight = newSemaphore(c.maxConcurrency)
+	return c
+}
+
+// defaultClient is used by the package-level functions.
+var defaultClient = NewClient()
+
+// ---- Helper methods ----
+// These methods are not
+// exported and are only used
+// internally by the library
+
+// newRequest returns a GET request for path, relative to the base URL.
+// The request must be released with fasthttp.ReleaseRequest.
+func (c *Client) newRequest(path string) *fasthttp.Request {
+	req := fasthttp.AcquireRequest()
+	req.SetRequestURI(c.baseURL + path)
+	req.Header.SetMethod(fasthttp.MethodGet)
+	req.Header.Set("User-Agent", c.userAgent)
+	return req
+}
+
+// do sends the request and fills resp,
+// retrying it as told by the retry policy of the client.
+// A 429 or 5xx response is returned as a *StatusError.
+// Once the request was retried, any error is wrapped in a *RetryError.
+func (c *Client) do(ctx context.Context, req *fasthttp.Request, resp *fasthttp.Response) error {
+	for attempt := 1; ; attempt++ {
+		err := c.send(ctx, req, resp)
+		if err == nil && isRetryableStatus(resp.StatusCode()) {
+			err = &StatusError{StatusCode: resp.StatusCode()}
+		} else if err == nil {
+			return nil
+		} else if !isRetryableError(req, err) {
+			return newRetryError(attempt, err)
+		}
+
+		if attempt >= c.retryPolicy.MaxAttempts {
+			return newRetryError(attempt, err)
+		}
+
+		delay := c.retryPolicy.backoff(attempt)
+		if wait := retryAfter(resp); wait > delay {
+			if c.retryPolicy.MaxDelay > 0 && wait > c.retryPolicy.MaxDelay {
+				// Retrying sooner than asked would be rejected again.
+				return newRetryError(attempt, err)
+			}
+			delay = wait
+		}
+		timer := time.NewTimer(delay)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return newRetryError(attempt, ctx.Err())
+		}
+	}
+}
+
+// send waits for the rate limiter and a free slot of the maximum concurrency,
+// then sends the request once and fills resp.
+// The request stops at the earliest of the context deadline
+// and the timeout of the client.
+// If ctx is canceled, send returns ctx.Err() without waiting for the response,
+// whose slot is freed once the abandoned request ends.
+func (c *Client) send(ctx context.Context, req *fasthttp.Request, resp *fasthttp.Response) error {
+	if err := c.limiter.wait(ctx); err != nil {
+		return err
+	}
+	if err := c.inFlight.acquire(ctx); err != nil {
+		return err
+	}
+
+	deadline, hasDeadline := ctx.Deadline()
+	ctxDeadline := hasDeadline
+	if c.timeout > 0 {
+		timeoutDeadline := time.Now().Add(c.timeout)
+		if !hasDeadline || timeoutDeadline.Before(deadline) {
+			deadline, hasDeadline, ctxDeadline = timeoutDeadline, true, false
+		}
+	}
+
+	send := func(req *fasthttp.Request, resp *fasthttp.Response) error {
+		if hasDeadline {
+			return c.httpClient.DoDeadline(req, resp, deadline)
+		}
+		return c.httpClient.Do(req, resp)
+	}
+
+	// A context that can never be canceled only needs the deadline.
+	if ctx.Done() == nil {
+		defer c.inFlight.release()
+		return send(req, resp)
+	}
+
+	// fasthttp cannot abort a request in flight, so it is sent on copies
+	// and abandoned on cancellation. The caller can then release req and resp
+	// while the copies are released once the request ends.
+	reqCopy := fasthttp.AcquireRequest()
+	req.CopyTo(reqCopy)
+	respCopy := fasthttp.AcquireResponse()
+	errc := make(chan error, 1)
+	go func() {
+		err := send(reqCopy, respCopy)
+		c.inFlight.release()
+		errc <- err
+	}()
+
+	select {
+	case err := <-errc:
+		respCopy.CopyTo(resp)
+		fasthttp.ReleaseRequest(reqCopy)
+		fasthttp.ReleaseResponse(respCopy)
+		if err != nil && ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if ctxDeadline && errors.Is(err, fasthttp.ErrTimeout) {
+			// The context deadline was hit by fasthttp
+			// before the context itself was done.
+			return context.DeadlineExceeded
+		}
+		return err
+	case <-ctx.Done():
+		go func() {
+			<-errc
+			fasthttp.ReleaseRequest(reqCopy)
+			fasthttp.ReleaseResponse(respCopy)
+		}()
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,488 @@
+package goyfinance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/valyala/fasthttp"
+	"github.com/valyala/fasthttp/fasthttputil"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// A small chart response for AAPL with three daily bars.
+const testChartJSON = `{"chart":{"result":[{"meta":{"currency":"USD","symbol":"AAPL","exchangeName":"NMS","instrumentType":"EQUITY","firstTradeDate":345479400,"regularMarketTime":1704488400,"gmtoffset":-18000,"timezone":"EST","exchangeTimezoneName":"America/New_York","regularMarketPrice":181.18,"chartPreviousClose":192.53,"priceHint":2,"currentTradingPeriod":{"pre":{"timezone":"EST","start":1704445200,"end":1704465000,"gmtoffset":-18000},"regular":{"timezone":"EST","start":1704465000,"end":1704488400,"gmtoffset":-18000},"post":{"timezone":"EST","start":1704488400,"end":1704502800,"gmtoffset":-18000}},"dataGranularity":"1d","range":"","validRanges":["1d","5d","1mo","3mo","6mo","1y","2y","5y","10y","ytd","max"]},"timestamp":[1704205800,1704292200,1704378600],"indicators":{"quote":[{"open":[187.15,184.22,182.15],"low":[183.89,181.5,180.88],"volume":[82488700,58414500,71983600],"high":[188.44,185.88,183.09],"close":[185.64,184.25,181.91]}]}}],"error":null}}`
+
+// newTestClient returns a Client talking to an in-memory server
+// that answers every request with handler.
+func newTestClient(t *testing.T, handler fasthttp.RequestHandler, opts ...Option) *Client {
+	t.Helper()
+	ln := fasthttputil.NewInmemoryListener()
+	server := &fasthttp.Server{Handler: handler}
+	go server.Serve(ln) //nolint:errcheck
+	t.Cleanup(func() {
+		ln.Close()
+	})
+
+	httpClient := &fasthttp.Client{
+		Dial: func(addr string) (net.Conn, error) {
+			return ln.Dial()
+		},
+	}
+	opts = append([]Option{WithBaseURL("http://yahoo.test"), WithCookieURL("http://yahoo.test/cookie"), WithHTTPClient(httpClient)}, opts...)
+	return NewClient(opts...)
+}
+
+func TestClientOptions(t *testing.T) {
+	var gotPath, gotUserAgent, gotPrePost string
+	client := newTestClient(t, func(ctx *fasthttp.RequestCtx) {
+		gotPath = string(ctx.Path())
+		gotPrePost = string(ctx.QueryArgs().Peek("includePrePost"))
+		gotUserAgent = string(ctx.UserAgent())
+		ctx.SetBodyString(testChartJSON)
+	}, WithUserAgent("goyfinance-test"), WithPrePost(true))
+
+	quote, err := client.GetQuote(context.Background(), "AAPL", IntervalOneDay, PeriodFiveDays)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if gotPath != "/v8/finance/chart/AAPL" {
+		t.Errorf("path is %q", gotPath)
+	}
+	if gotUserAgent != "goyfinance-test" {
+		t.Errorf("User-Agent is %q", gotUserAgent)
+	}
+	if gotPrePost != "true" {
+		t.Errorf("includePrePost is %q", gotPrePost)
+	}
+	if quote.Ticker != "AAPL" || len(quote.PriceHistoric) != 3 {
+		t.Fatalf("unexpected quote %+v", quote)
+	}
+	if quote.PriceHistoric[2].ClosePrice != 181.91 {
+		t.Errorf("last close is %f", quote.PriceHistoric[2].ClosePrice)
+	}
+}
+
+func TestClientTimeout(t *testing.T) {
+	client := newTestClient(t, func(ctx *fasthttp.RequestCtx) {
+		time.Sleep(200 * time.Millisecond)
+		ctx.SetBodyString(testChartJSON)
+	}, WithTimeout(20*time.Millisecond), WithRetryPolicy(RetryPolicy{MaxAttempts: 1}))
+
+	_, err := client.GetQuoteJSONString(context.Background(), "AAPL", IntervalOneDay, PeriodFiveDays)
+	if err == nil || !strings.Contains(err.Error(), "timeout") {
+		t.Fatalf("expected a timeout error, got %v", err)
+	}
+}
+
+func TestClientContextCanceled(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+	client := newTestClient(t, func(ctx *fasthttp.RequestCtx) {
+		<-release
+		ctx.SetBodyString(testChartJSON)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	_, err := client.GetQuote(ctx, "AAPL", IntervalOneDay, PeriodFiveDays)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected context.DeadlineExceeded, got %v", err)
+	}
+	if time.Since(start) > time.Second {
+		t.Errorf("GetQuote did not return on cancellation")
+	}
+}
+
+func TestClientContextDeadlineWithoutRetry(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+	client := newTestClient(t, func(ctx *fasthttp.RequestCtx) {
+		<-release
+		ctx.SetBodyString(testChartJSON)
+	}, WithRetryPolicy(RetryPolicy{MaxAttempts: 1}))
+
+	for i := 0; i < 10; i++ {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		_, err := client.GetQuote(ctx, "AAPL", IntervalOneDay, PeriodFiveDays)
+		cancel()
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Fatalf("expected context.DeadlineExceeded, got %v", err)
+		}
+	}
+}
+
+func TestContinuousPriceUpdaterStops(t *testing.T) {
+	client := newTestClient(t, func(ctx *fasthttp.RequestCtx) {
+		ctx.SetBodyString(testChartJSON)
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	prices := make(chan PriceData)
+	errs := make(chan error)
+	done := make(chan struct{})
+	go func() {
+		client.ContinuousPriceUpdater(ctx, prices, errs, "AAPL", IntervalOneDay, PeriodFiveDays, 0.01)
+		close(done)
+	}()
+
+	for i := 0; i < 2; i++ {
+		select {
+		case price := <-prices:
+			if price.ClosePrice != 181.91 {
+				t.Errorf("close is %f", price.ClosePrice)
+			}
+		case err := <-errs:
+			t.Fatal(err)
+		}
+	}
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("ContinuousPriceUpdater did not stop")
+	}
+}
+
+func TestGetQuoteBatchOrderAndErrors(t *testing.T) {
+	client := newTestClient(t, func(ctx *fasthttp.RequestCtx) {
+		if string(ctx.Path()) == "/v8/finance/chart/FAIL" {
+			ctx.SetBodyString("not json")
+			return
+		}
+		ctx.SetBodyString(testChartJSON)
+	})
+
+	tickers := []string{"AAPL", "FAIL", "MSFT"}
+	quotes, err := client.GetQuoteBatch(context.Background(), tickers, IntervalOneDay, PeriodFiveDays)
+	if len(quotes) != len(tickers) {
+		t.Fatalf("got %d quotes for %d tickers", len(quotes), len(tickers))
+	}
+	if quotes[0].Ticker != "AAPL" || quotes[1].Ticker != "" || quotes[2].Ticker != "MSFT" {
+		t.Errorf("quotes are not in ticker order: %q %q %q", quotes[0].Ticker, quotes[1].Ticker, quotes[2].Ticker)
+	}
+
+	var batchErr *BatchError
+	if !errors.As(err, &batchErr) {
+		t.Fatalf("expected a *BatchError, got %v", err)
+	}
+	if failed := batchErr.Tickers(); len(failed) != 1 || failed[0] != "FAIL" {
+		t.Errorf("failed tickers are %v", failed)
+	}
+	if batchErr.Err(1) == nil || batchErr.Err(0) != nil {
+		t.Errorf("unexpected per-ticker errors %v, %v", batchErr.Err(0), batchErr.Err(1))
+	}
+}
+
+func TestBatchMaxConcurrency(t *testing.T) {
+	var mu sync.Mutex
+	var inFlight, maxInFlight int
+	client := newTestClient(t, func(ctx *fasthttp.RequestCtx) {
+		mu.Lock()
+		inFlight++
+		if inFlight > maxInFlight {
+			maxInFlight = inFlight
+		}
+		mu.Unlock()
+		time.Sleep(10 * time.Millisecond)
+		mu.Lock()
+		inFlight--
+		mu.Unlock()
+		ctx.SetBodyString(testChartJSON)
+	}, WithMaxConcurrency(3))
+
+	_, err := client.GetQuoteBatch(context.Background(), aaplList(12), IntervalOneDay, PeriodFiveDays)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if maxInFlight > 3 {
+		t.Errorf("%d requests were sent at the same time", maxInFlight)
+	}
+
+	// Quotes split into chunks keep to the same limit.
+	maxInFlight = 0
+	now := time.Now()
+	month := DateRange{Start: now.AddDate(0, 0, -28), End: now}
+	_, err = client.GetQuoteBatch(context.Background(), aaplList(6), IntervalOneMinute, month)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if maxInFlight > 3 {
+		t.Errorf("%d chunk requests were sent at the same time", maxInFlight)
+	}
+}
+
+func TestRateLimiter(t *testing.T) {
+	limiter := newRateLimiter(50, 2)
+	start := time.Now()
+	for i := 0; i < 7; i++ {
+		if err := limiter.wait(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// 2 requests use the burst, the 5 others are spaced by 20ms.
+	if elapsed := time.Since(start); elapsed < 90*time.Millisecond {
+		t.Errorf("7 requests took %s", elapsed)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := limiter.wait(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+}
+
+func TestClientRetry(t *testing.T) {
+	var mu sync.Mutex
+	requests := 0
+	client := newTestClient(t, func(ctx *fasthttp.RequestCtx) {
+		mu.Lock()
+		requests++
+		n := requests
+		mu.Unlock()
+		if n < 3 {
+			ctx.Response.Header.Set("Retry-After", "0")
+			ctx.SetStatusCode(fasthttp.StatusServiceUnavailable)
+			return
+		}
+		ctx.SetBodyString(testChartJSON)
+	}, WithRetryPolicy(RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond}))
+
+	quote, err := client.GetQuote(context.Background(), "AAPL", IntervalOneDay, PeriodFiveDays)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if requests != 3 || len(quote.PriceHistoric) != 3 {
+		t.Errorf("got %d bars after %d requests", len(quote.PriceHistoric), requests)
+	}
+}
+
+func TestClientRetryGivesUp(t *testing.T) {
+	client := newTestClient(t, func(ctx *fasthttp.RequestCtx) {
+		ctx.SetStatusCode(fasthttp.StatusTooManyRequests)
+	}, WithRetryPolicy(RetryPolicy{MaxAttempts: 2, BaseDelay: time.Millisecond}))
+
+	_, err := client.GetQuoteJSONString(context.Background(), "AAPL", IntervalOneDay, PeriodFiveDays)
+	var retryErr *RetryError
+	if !errors.As(err, &retryErr) || retryErr.Attempts != 2 {
+		t.Fatalf("expected a *RetryError after 2 attempts, got %v", err)
+	}
+	var statusErr *StatusError
+	if !errors.As(err, &statusErr) || statusErr.StatusCode != fasthttp.StatusTooManyRequests {
+		t.Errorf("expected a 429 *StatusError, got %v", err)
+	}
+}
+
+func TestClientRetryAfterLongerThanMaxDelay(t *testing.T) {
+	var mu sync.Mutex
+	requests := 0
+	client := newTestClient(t, func(ctx *fasthttp.RequestCtx) {
+		mu.Lock()
+		requests++
+		mu.Unlock()
+		ctx.Response.Header.Set("Retry-After", "3600")
+		ctx.SetStatusCode(fasthttp.StatusTooManyRequests)
+	}, WithRetryPolicy(RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: 10 * time.Millisecond}))
+
+	start := time.Now()
+	_, err := client.GetQuoteJSONString(context.Background(), "AAPL", IntervalOneDay, PeriodFiveDays)
+	if time.Since(start) > time.Second {
+		t.Errorf("waited %s for the Retry-After", time.Since(start))
+	}
+	var statusErr *StatusError
+	if !errors.As(err, &statusErr) || statusErr.StatusCode != fasthttp.StatusTooManyRequests || !errors.Is(err, ErrRateLimited) {
+		t.Errorf("expected a 429 *StatusError, got %v", err)
+	}
+	if requests != 1 {
+		t.Errorf("sent %d requests", requests)
+	}
+}
+
+func TestClientRetryErrorAttempts(t *testing.T) {
+	var mu sync.Mutex
+	requests := 0
+	client := newTestClient(t, func(ctx *fasthttp.RequestCtx) {
+		mu.Lock()
+		requests++
+		n := requests
+		mu.Unlock()
+		if n > 1 {
+			ctx.Response.Header.Set("Retry-After", "10")
+		}
+		ctx.SetStatusCode(fasthttp.StatusServiceUnavailable)
+	}, WithRetryPolicy(RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond}))
+
+	// The context is done while waiting for the Retry-After of the second attempt.
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	_, err := client.GetQuoteJSONString(ctx, "AAPL", IntervalOneDay, PeriodFiveDays)
+	var retryErr *RetryError
+	if !errors.As(err, &retryErr) || retryErr.Attempts != 2 || !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected a *RetryError after 2 attempts wrapping context.DeadlineExceeded, got %v", err)
+	}
+
+	// Without retries, the error is not wrapped.
+	client = newTestClient(t, func(ctx *fasthttp.RequestCtx) {
+		ctx.SetStatusCode(fasthttp.StatusTooManyRequests)
+	}, WithRetryPolicy(RetryPolicy{MaxAttempts: 1}))
+	_, err = client.GetQuoteJSONString(context.Background(), "AAPL", IntervalOneDay, PeriodFiveDays)
+	var statusErr *StatusError
+	if errors.As(err, &retryErr) || !errors.As(err, &statusErr) {
+		t.Errorf("expected a bare *StatusError, got %v", err)
+	}
+}
+
+func TestGetQuoteErrors(t *testing.T) {
+	client := newTestClient(t, func(ctx *fasthttp.RequestCtx) {
+		switch string(ctx.Path()) {
+		case "/v8/finance/chart/DELISTED":
+			ctx.SetStatusCode(fasthttp.StatusNotFound)
+			ctx.SetBodyString(`{"chart":{"result":null,"error":{"code":"Not Found","description":"No data found, symbol may be delisted"}}}`)
+		case "/v8/finance/chart/EMPTY":
+			ctx.SetBodyString(`{"chart":{"result":null,"error":null}}`)
+		case "/v8/finance/chart/INTERVAL":
+			ctx.SetStatusCode(fasthttp.StatusUnprocessableEntity)
+			ctx.SetBodyString(`{"chart":{"result":null,"error":{"code":"Unprocessable Entity","description":"Invalid input - interval=4h is not supported. Valid intervals: [1m, 2m, 5m, 15m, 30m, 60m, 90m, 1h, 1d, 5d, 1wk, 1mo, 3mo]"}}}`)
+		case "/v8/finance/chart/RANGE":
+			ctx.SetStatusCode(fasthttp.StatusBadRequest)
+			ctx.SetBodyString(`{"chart":{"result":null,"error":{"code":"Bad Request","description":"Data doesn't exist for startDate = 1704205800, endDate = 1704118800"}}}`)
+		case "/v8/finance/chart/UNPROCESSABLE":
+			ctx.SetStatusCode(fasthttp.StatusUnprocessableEntity)
+		case "/v1/test/getcrumb":
+			ctx.SetBodyString("crumb")
+		case "/v7/finance/download/AAPL":
+			ctx.SetStatusCode(fasthttp.StatusUnauthorized)
+			ctx.SetBodyString(`{"finance":{"result":null,"error":{"code":"Unauthorized","description":"Invalid cookie"}}}`)
+		}
+	})
+
+	_, err := client.GetQuote(context.Background(), "DELISTED", IntervalOneDay, PeriodFiveDays)
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.Description != "No data found, symbol may be delisted" {
+		t.Errorf("expected an *APIError, got %v", err)
+	}
+	if !errors.Is(err, ErrTickerNotFound) {
+		t.Errorf("expected ErrTickerNotFound, got %v", err)
+	}
+
+	_, err = client.GetQuote(context.Background(), "EMPTY", IntervalOneDay, PeriodFiveDays)
+	if !errors.Is(err, ErrNoData) {
+		t.Errorf("expected ErrNoData, got %v", err)
+	}
+
+	_, err = client.GetQuote(context.Background(), "INTERVAL", IntervalOneDay, PeriodFiveDays)
+	if !errors.Is(err, ErrInvalidInterval) || errors.Is(err, ErrInvalidRange) {
+		t.Errorf("expected ErrInvalidInterval, got %v", err)
+	}
+	_, err = client.GetQuote(context.Background(), "RANGE", IntervalOneDay, PeriodFiveDays)
+	if !errors.Is(err, ErrInvalidRange) || errors.Is(err, ErrInvalidInterval) {
+		t.Errorf("expected ErrInvalidRange, got %v", err)
+	}
+	_, err = client.GetQuote(context.Background(), "UNPROCESSABLE", IntervalOneDay, PeriodFiveDays)
+	if !errors.Is(err, ErrInvalidRange) {
+		t.Errorf("expected ErrInvalidRange for a bare 422, got %v", err)
+	}
+
+	_, err = client.GetQuoteCSVString(context.Background(), "AAPL", IntervalOneDay, PeriodFiveDays)
+	if !errors.Is(err, ErrUnauthorized) || !errors.As(err, &apiErr) || apiErr.Description != "Invalid cookie" {
+		t.Errorf("expected the Unauthorized error of the download endpoint, got %v", err)
+	}
+}
+
+func TestGetDividendsAndSplits(t *testing.T) {
+	var gotEvents string
+	client := newTestClient(t, func(ctx *fasthttp.RequestCtx) {
+		gotEvents = string(ctx.QueryArgs().Peek("events"))
+		ctx.SetBodyString(`{"chart":{"result":[{"meta":{"exchangeTimezoneName":"America/New_York","dataGranularity":"1d"},"timestamp":[1598880600],"events":{"dividends":{"1604673000":{"amount":0.205,"date":1604673000},"1597325400":{"amount":0.82,"date":1597325400}},"splits":{"1598880600":{"date":1598880600,"numerator":4.0,"denominator":1.0,"splitRatio":"4:1"}}},"indicators":{"quote":[{"open":[127.58],"low":[126],"volume":[225702700],"high":[131],"close":[129.04]}]}}],"error":null}}`)
+	})
+
+	dividends, err := client.GetDividends(context.Background(), "AAPL", PeriodFiveYear)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if gotEvents != "div,split,capitalGains" {
+		t.Errorf("events parameter is %q", gotEvents)
+	}
+	if len(dividends) != 2 || dividends[0].Amount != 0.82 || dividends[1].Timestamp != 1604673000 {
+		t.Errorf("unexpected dividends %+v", dividends)
+	}
+
+	splits, err := client.GetSplits(context.Background(), "AAPL", PeriodFiveYear)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(splits) != 1 || splits[0].Numerator != 4 || splits[0].Denominator != 1 || splits[0].Ratio != "4:1" {
+		t.Errorf("unexpected splits %+v", splits)
+	}
+}
+
+func TestGetQuoteNativeRange(t *testing.T) {
+	var gotRange, gotPeriod1 string
+	client := newTestClient(t, func(ctx *fasthttp.RequestCtx) {
+		gotRange = string(ctx.QueryArgs().Peek("range"))
+		gotPeriod1 = string(ctx.QueryArgs().Peek("period1"))
+		ctx.SetBodyString(strings.Replace(testChartJSON, `"range":""`, `"range":"max"`, 1))
+	})
+
+	quote, err := client.GetQuote(context.Background(), "AAPL", IntervalOneDay, NativeRange(PeriodMax))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if gotRange != "max" || gotPeriod1 != "" {
+		t.Errorf("sent range=%q and period1=%q", gotRange, gotPeriod1)
+	}
+	if quote.ServedRange != PeriodMax || len(quote.ValidRanges) != 11 || quote.ValidRanges[10] != PeriodMax {
+		t.Errorf("served range is %q, valid ranges are %v", quote.ServedRange, quote.ValidRanges)
+	}
+	if quote.PriceRangeStart != 1704205800 || quote.PriceRangeEnd != 1704378600 {
+		t.Errorf("price range is %d-%d", quote.PriceRangeStart, quote.PriceRangeEnd)
+	}
+}
+
+func TestGetQuoteChunks(t *testing.T) {
+	var mu sync.Mutex
+	var ranges [][2]int64
+	client := newTestClient(t, func(ctx *fasthttp.RequestCtx) {
+		period1, _ := ctx.QueryArgs().GetUint("period1")
+		period2, _ := ctx.QueryArgs().GetUint("period2")
+		mu.Lock()
+		ranges = append(ranges, [2]int64{int64(period1), int64(period2)})
+		mu.Unlock()
+		// One bar and one session at each end of the chunk, so that chunks share a bar and a session.
+		ctx.SetBodyString(fmt.Sprintf(`{"chart":{"result":[{"meta":{"dataGranularity":"1m",`+
+			`"tradingPeriods":[[{"timezone":"EST","start":%d,"end":%d,"gmtoffset":-18000}],[{"timezone":"EST","start":%d,"end":%d,"gmtoffset":-18000}]]},`+
+			`"timestamp":[%d,%d],"indicators":{"quote":[{"open":[1,2],"low":[1,2],"volume":[1,2],"high":[1,2],"close":[1,2]}]}}],"error":null}}`,
+			period1, period1+60, period2, period2+60, period1, period2))
+	})
+
+	now := time.Now()
+	month := DateRange{Start: now.AddDate(0, 0, -28), End: now}
+	quote, err := client.GetQuote(context.Background(), "AAPL", IntervalOneMinute, month)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ranges) != 4 {
+		t.Fatalf("sent %d requests for 28 days of 1m data", len(ranges))
+	}
+	// 4 chunks with 2 bars each, sharing 3 bars.
+	if len(quote.PriceHistoric) != 5 {
+		t.Fatalf("got %d bars", len(quote.PriceHistoric))
+	}
+	for i := 1; i < len(quote.PriceHistoric); i++ {
+		if quote.PriceHistoric[i].Timestamp <= quote.PriceHistoric[i-1].Timestamp {
+			t.Errorf("bars are not in chronological order")
+		}
+	}
+	if quote.PriceRangeStart != month.Start.Unix() || quote.PriceRangeEnd != month.End.Unix() {
+		t.Errorf("price range is %d-%d", quote.PriceRangeStart, quote.PriceRangeEnd)
+	}
+	// The sessions of every chunk are kept, not only the ones of the last chunk.
+	periods := quote.Meta.TradingPeriods
+	if len(periods) != 5 || periods[0].Start.Unix() != month.Start.Unix() || periods[4].Start.Unix() != month.End.Unix() {
+		t.Errorf("got trading periods %v", periods)
+	}
+}
@@ -0,0 +1,145 @@
+package goyfinance
+
+import (
+	"context"
+	"fmt"
+	"github.com/valyala/fasthttp"
+	"net/url"
+	"strings"
+)
+
+// DefaultCookieURL is the page requested for the session cookie
+// when no cookie URL is given.
+// It answers 404 but sets the A3 cookie Yahoo expects along with the crumb.
+const DefaultCookieURL = "https://fc.yahoo.com"
+
+// crumbSession is the cookie and the crumb Yahoo requires
+// on the quote, quoteSummary and download endpoints.
+// They are fetched on first use, cached and shared by every request of a Client,
+// and fetched again when Yahoo rejects them.
+type crumbSession struct {
+	// lock is held while reading or fetching the cookie and the crumb.
+	// It is a channel rather than a mutex so that waiting for it stops with the context,
+	// while only one goroutine fetches them and the others reuse the result.
+	lock   chan struct{}
+	cookie string // Value of the Cookie header, for example "A3=d=AQABBK..."
+	crumb  string
+}
+
+// newCrumbSession returns an empty session.
+func newCrumbSession() *crumbSession {
+	return &crumbSession{lock: make(chan struct{}, 1)}
+}
+
+// get returns the cached cookie and crumb,
+// fetching them with c first if there are none.
+func (s *crumbSession) get(ctx context.Context, c *Client) (string, string, error) {
+	select {
+	case s.lock <- struct{}{}:
+	case <-ctx.Done():
+		return "", "", ctx.Err()
+	}
+	defer func() { <-s.lock }()
+
+	if s.crumb == "" {
+		cookie, crumb, err := c.newCrumb(ctx)
+		if err != nil {
+			return "", "", err
+		}
+		s.cookie, s.crumb = cookie, crumb
+	}
+	return s.cookie, s.crumb, nil
+}
+
+// invalidate drops the cached crumb if it is still crumb,
+// so that concurrent requests rejected with the same crumb only fetch a new one once.
+func (s *crumbSession) invalidate(crumb string) {
+	s.lock <- struct{}{}
+	if s.crumb == crumb {
+		s.cookie, s.crumb = "", ""
+	}
+	<-s.lock
+}
+
+// newCrumb fetches a session cookie from the cookie URL,
+// then a crumb for that cookie from the getcrumb endpoint.
+func (c *Client) newCrumb(ctx context.Context) (string, string, error) {
+	resp := fasthttp.AcquireResponse()
+	defer fasthttp.ReleaseResponse(resp)
+
+	req := fasthttp.AcquireRequest()
+	req.SetRequestURI(c.cookieURL)
+	req.Header.SetMethod(fasthttp.MethodGet)
+	req.Header.Set("User-Agent", c.userAgent)
+	err := c.do(ctx, req, resp)
+	fasthttp.ReleaseRequest(req)
+	if err != nil {
+		return "", "", fmt.Errorf("goyfinance: getting a session cookie: %w", err)
+	}
+
+	// The status code is ignored, the cookie page answers 404.
+	var cookies []string
+	resp.Header.VisitAllCookie(func(key, value []byte) {
+		cookie := fasthttp.AcquireCookie()
+		if cookie.ParseBytes(value) == nil {
+			cookies = append(cookies, string(cookie.Key())+"="+string(cookie.Value()))
+		}
+		fasthttp.ReleaseCookie(cookie)
+	})
+	cookie := strings.Join(cookies, "; ")
+
+	req = c.newRequest("/v1/test/getcrumb")
+	defer fasthttp.ReleaseRequest(req)
+	if cookie != "" {
+		req.Header.Set("Cookie", cookie)
+	}
+	err = c.do(ctx, req, resp)
+	if err != nil {
+		return "", "", fmt.Errorf("goyfinance: getting a crumb: %w", err)
+	}
+	if resp.StatusCode() != fasthttp.StatusOK {
+		return "", "", fmt.Errorf("goyfinance: getting a crumb: %w", parseErrorResponse(resp.StatusCode(), resp.Body()))
+	}
+
+	crumb := strings.TrimSpace(string(resp.Body()))
+	if crumb == "" || strings.ContainsAny(crumb, "<{ ") {
+		return "", "", fmt.Errorf("goyfinance: no crumb in the getcrumb response: %w", ErrUnauthorized)
+	}
+	return cookie, crumb, nil
+}
+
+// fetchWithCrumb is like fetch, for the endpoints requiring a cookie and a crumb.
+// The crumb is added to the query of path, and if Yahoo rejects it
+// with 401 Unauthorized, a new one is fetched and the request sent again once.
+func (c *Client) fetchWithCrumb(ctx context.Context, path string, resp *fasthttp.Response) error {
+	separator := "?"
+	if strings.Contains(path, "?") {
+		separator = "&"
+	}
+
+	for attempt := 1; ; attempt++ {
+		cookie, crumb, err := c.session.get(ctx, c)
+		if err != nil {
+			return err
+		}
+
+		req := c.newRequest(path + separator + "crumb=" + url.QueryEscape(crumb))
+		if cookie != "" {
+			req.Header.Set("Cookie", cookie)
+		}
+		err = c.do(ctx, req, resp)
+		fasthttp.ReleaseRequest(req)
+		if err != nil {
+			return err
+		}
+
+		if resp.StatusCode() == fasthttp.StatusUnauthorized && attempt == 1 {
+			c.session.invalidate(crumb)
+			continue
+		}
+		if resp.StatusCode() != fasthttp.StatusOK {
+			return parseErrorResponse(resp.StatusCode(), resp.Body())
+		}
+		return nil
+	}
+}
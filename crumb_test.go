@@ -0,0 +1,79 @@
+package goyfinance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/valyala/fasthttp"
+	"sync"
+	"testing"
+)
+
+func TestCrumbSession(t *testing.T) {
+	var mu sync.Mutex
+	var cookieRequests, crumbRequests int
+	validCrumb := "crumb1"
+	client := newTestClient(t, func(ctx *fasthttp.RequestCtx) {
+		mu.Lock()
+		defer mu.Unlock()
+		switch string(ctx.Path()) {
+		case "/cookie":
+			cookieRequests++
+			ctx.Response.Header.Set("Set-Cookie", "A3=d=session; Domain=.yahoo.com; Path=/; Secure; HttpOnly")
+			ctx.SetStatusCode(fasthttp.StatusNotFound)
+		case "/v1/test/getcrumb":
+			crumbRequests++
+			if string(ctx.Request.Header.Cookie("A3")) != "d=session" {
+				ctx.SetStatusCode(fasthttp.StatusUnauthorized)
+				return
+			}
+			ctx.SetBodyString(fmt.Sprintf("crumb%d", crumbRequests))
+		default:
+			if string(ctx.QueryArgs().Peek("crumb")) != validCrumb || len(ctx.Request.Header.Cookie("A3")) == 0 {
+				ctx.SetStatusCode(fasthttp.StatusUnauthorized)
+				ctx.SetBodyString(`{"finance":{"result":null,"error":{"code":"Unauthorized","description":"Invalid Crumb"}}}`)
+				return
+			}
+			ctx.SetBodyString(`{"quoteResponse":{"result":[{"symbol":"AAPL"}],"error":null}}`)
+		}
+	})
+
+	var wg sync.WaitGroup
+	errs := make([]error, 20)
+	for i := range errs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, errs[i] = client.GetSnapshot(context.Background(), "AAPL")
+		}(i)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if cookieRequests != 1 || crumbRequests != 1 {
+		t.Errorf("fetched %d cookies and %d crumbs for concurrent requests", cookieRequests, crumbRequests)
+	}
+
+	// Yahoo expires the crumb, the next request gets a new one and succeeds.
+	mu.Lock()
+	validCrumb = "crumb2"
+	mu.Unlock()
+	if _, err := client.GetSnapshot(context.Background(), "AAPL"); err != nil {
+		t.Fatal(err)
+	}
+	if crumbRequests != 2 {
+		t.Errorf("fetched %d crumbs after the crumb expired", crumbRequests)
+	}
+
+	// A crumb rejected again is not fetched in a loop.
+	mu.Lock()
+	validCrumb = "never"
+	mu.Unlock()
+	_, err := client.GetSnapshot(context.Background(), "AAPL")
+	if !errors.Is(err, ErrUnauthorized) || crumbRequests != 3 {
+		t.Errorf("got %v after fetching %d crumbs", err, crumbRequests)
+	}
+}
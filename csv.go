@@ -0,0 +1,218 @@
+package goyfinance
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"github.com/valyala/fasthttp"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// csvTimeLayouts are the layouts of the Date column of the CSV download,
+// a date for daily and longer intervals, and a time with its offset otherwise.
+var csvTimeLayouts = []string{
+	time.DateOnly,
+	"2006-01-02 15:04:05-07:00",
+	time.RFC3339,
+}
+
+// ParseCSV parses OHLCV data in the format of the CSV download,
+// with a "Date,Open,High,Low,Close,Adj Close,Volume" header.
+// The columns are found by name, and Adj Close is optional.
+// A row of "null" values, which Yahoo sends for a day without trades,
+// is returned as a bar with Missing set, like GetQuote does.
+// The CSV does not tell the timezone of the exchange,
+// so a date is returned as midnight UTC of that date:
+// compare it with the bars of GetQuote with Time.Date,
+// or use ParseCSVInLocation if the timezone of the exchange is known.
+func ParseCSV(r io.Reader) ([]PriceData, error) {
+	return ParseCSVInLocation(r, time.UTC)
+}
+
+// ParseCSVInLocation is like ParseCSV but returns a date as midnight of that date in location,
+// which should be the timezone of the exchange, like Quote.Meta.Location.
+// The times of intraday bars, which have their offset in the CSV, are converted to location.
+// The chart timestamps daily bars at the open of the regular session instead of midnight,
+// so compare the two with Time.Date, which then gives the same date.
+func ParseCSVInLocation(r io.Reader, location *time.Location) ([]PriceData, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("goyfinance: reading the CSV header: %w", err)
+	}
+
+	columns := map[string]int{"date": -1, "open": -1, "high": -1, "low": -1, "close": -1, "adj close": -1, "volume": -1}
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))
+		if _, ok := columns[name]; ok {
+			columns[name] = i
+		}
+	}
+	for name, i := range columns {
+		if i < 0 && name != "adj close" {
+			return nil, fmt.Errorf("goyfinance: no %q column in the CSV header %q", name, strings.Join(header, ","))
+		}
+	}
+
+	var prices []PriceData
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return prices, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("goyfinance: reading the CSV: %w", err)
+		}
+		line, _ := reader.FieldPos(0)
+
+		priceData := PriceData{Session: SessionRegular}
+		priceData.Time, err = parseCSVTime(record[columns["date"]], location)
+		if err != nil {
+			return nil, fmt.Errorf("goyfinance: CSV line %d: %w", line, err)
+		}
+		priceData.Timestamp = priceData.Time.Unix()
+
+		var defined bool
+		fields := []struct {
+			column string
+			value  *float64
+		}{
+			{"open", &priceData.OpenPrice},
+			{"high", &priceData.HighPrice},
+			{"low", &priceData.LowPrice},
+			{"close", &priceData.ClosePrice},
+		}
+		for _, field := range fields {
+			*field.value, defined, err = parseCSVFloat(record[columns[field.column]])
+			if err != nil {
+				return nil, fmt.Errorf("goyfinance: CSV line %d: %s: %w", line, field.column, err)
+			}
+			priceData.Missing = priceData.Missing || !defined
+		}
+
+		volume, defined, err := parseCSVFloat(record[columns["volume"]])
+		if err != nil {
+			return nil, fmt.Errorf("goyfinance: CSV line %d: volume: %w", line, err)
+		}
+		priceData.Volume = int(volume)
+		priceData.Missing = priceData.Missing || !defined
+
+		priceData.AdjClosePrice = priceData.ClosePrice
+		if i := columns["adj close"]; i >= 0 {
+			adjClose, defined, err := parseCSVFloat(record[i])
+			if err != nil {
+				return nil, fmt.Errorf("goyfinance: CSV line %d: adj close: %w", line, err)
+			}
+			if defined {
+				priceData.AdjClosePrice = adjClose
+			}
+		}
+
+		prices = append(prices, priceData)
+	}
+}
+
+// parseCSVTime parses the Date column of the CSV download in location.
+func parseCSVTime(value string, location *time.Location) (time.Time, error) {
+	for _, layout := range csvTimeLayouts {
+		if t, err := time.ParseInLocation(layout, value, location); err == nil {
+			return t.In(location), nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid date %q", value)
+}
+
+// parseCSVFloat parses a number of the CSV download,
+// and tells whether it is defined, "null" and an empty field being undefined.
+func parseCSVFloat(value string) (float64, bool, error) {
+	if value == "" || value == "null" {
+		return 0, false, nil
+	}
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, false, fmt.Errorf("invalid number %q", value)
+	}
+	return f, true, nil
+}
+
+// GetQuoteCSV returns a Quote struct from the CSV download of Yahoo Finance.
+// Its bars have the same fields as the ones of GetQuote, including the adjusted close,
+// but their times are dates at midnight UTC, see ParseCSV.
+// To get dates in the timezone of the exchange, parse GetQuoteCSVString
+// with ParseCSVInLocation and the Meta.Location of a Quote of the ticker.
+// The metadata and the corporate actions are not part of the CSV, so they are empty.
+// If an error occurs, the Quote struct will be empty.
+func (c *Client) GetQuoteCSV(ctx context.Context, ticker string, interval Interval, period Range) (Quote, error) {
+	if err := Validate(interval, period); err != nil {
+		return Quote{}, err
+	}
+
+	path, period1, period2, err := downloadPath(ticker, interval, period)
+	if err != nil {
+		return Quote{}, err
+	}
+
+	resp := fasthttp.AcquireResponse()
+	defer fasthttp.ReleaseResponse(resp)
+
+	err = c.fetchWithCrumb(ctx, path, resp)
+	if err != nil {
+		return Quote{}, err
+	}
+
+	prices, err := ParseCSV(bytes.NewReader(resp.Body()))
+	if err != nil {
+		return Quote{}, err
+	}
+	return Quote{
+		Ticker:          ticker,
+		PriceRangeStart: period1,
+		PriceRangeEnd:   period2,
+		Interval:        interval,
+		PriceHistoric:   fillMissingBars(prices, c.missingBars),
+	}, nil
+}
+
+// GetQuoteCSV returns a Quote struct from the CSV download of Yahoo Finance
+// using the default client.
+// If an error occurs, the Quote struct will be empty.
+func GetQuoteCSV(ticker string, interval Interval, period Range) (Quote, error) {
+	return GetQuoteCSVCtx(context.Background(), ticker, interval, period)
+}
+
+// GetQuoteCSVCtx is like GetQuoteCSV but takes a context
+// to cancel the request or set its deadline.
+func GetQuoteCSVCtx(ctx context.Context, ticker string, interval Interval, period Range) (Quote, error) {
+	return defaultClient.GetQuoteCSV(ctx, ticker, interval, period)
+}
+
+// GetQuoteCSVBatch returns a slice of Quote structs from the CSV download of Yahoo Finance.
+// The order of the slice is the same as the order of the tickers slice.
+// If a ticker fails, its Quote struct is empty and the error is a *BatchError.
+func (c *Client) GetQuoteCSVBatch(ctx context.Context, tickers []string, interval Interval, period Range) ([]Quote, error) {
+	return runBatch(ctx, c.maxConcurrency, tickers, func(ctx context.Context, ticker string) (Quote, error) {
+		return c.GetQuoteCSV(ctx, ticker, interval, period)
+	})
+}
+
+// GetQuoteCSVBatch returns a slice of Quote structs from the CSV download of Yahoo Finance
+// using the default client.
+// The order of the slice is the same as the order of the tickers slice.
+// If a ticker fails, its Quote struct is empty and the error is a *BatchError.
+func GetQuoteCSVBatch(tickers []string, interval Interval, period Range) ([]Quote, error) {
+	return GetQuoteCSVBatchCtx(context.Background(), tickers, interval, period)
+}
+
+// GetQuoteCSVBatchCtx is like GetQuoteCSVBatch but takes a context
+// to cancel the request or set its deadline.
+func GetQuoteCSVBatchCtx(ctx context.Context, tickers []string, interval Interval, period Range) ([]Quote, error) {
+	return defaultClient.GetQuoteCSVBatch(ctx, tickers, interval, period)
+}
@@ -0,0 +1,113 @@
+package goyfinance
+
+import (
+	"context"
+	"github.com/valyala/fasthttp"
+	"strings"
+	"testing"
+	"time"
+)
+
+// The CSV download of the bars of testChartJSON, with a day without trades.
+const testCSV = `Date,Open,High,Low,Close,Adj Close,Volume
+2024-01-02,187.15,188.44,183.89,185.64,184.94,82488700
+2024-01-03,184.22,185.88,181.5,184.25,183.56,58414500
+2024-01-04,182.15,183.09,180.88,181.91,181.23,71983600
+2024-01-05,null,null,null,null,null,null
+`
+
+func TestParseCSV(t *testing.T) {
+	prices, err := ParseCSV(strings.NewReader(testCSV))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(prices) != 4 {
+		t.Fatalf("parsed %d bars", len(prices))
+	}
+	first := prices[0]
+	if first.OpenPrice != 187.15 || first.HighPrice != 188.44 || first.LowPrice != 183.89 ||
+		first.ClosePrice != 185.64 || first.AdjClosePrice != 184.94 || first.Volume != 82488700 ||
+		first.Missing || first.Session != SessionRegular {
+		t.Errorf("unexpected bar %+v", first)
+	}
+	if !prices[3].Missing || prices[3].ClosePrice != 0 || prices[2].Missing {
+		t.Errorf("null row was not parsed as missing: %+v", prices[3])
+	}
+
+	// The CSV and the chart have the same bars, compared by date.
+	quote, err := parseJSONtoQuote([]byte(testChartJSON), "AAPL", 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, priceData := range quote.PriceHistoric {
+		y1, m1, d1 := priceData.Time.Date()
+		y2, m2, d2 := prices[i].Time.Date()
+		if y1 != y2 || m1 != m2 || d1 != d2 || priceData.OpenPrice != prices[i].OpenPrice ||
+			priceData.ClosePrice != prices[i].ClosePrice || priceData.Volume != prices[i].Volume {
+			t.Errorf("bar %d differs: %+v and %+v", i, priceData, prices[i])
+		}
+	}
+
+	if prices[0].Time.Location() != time.UTC || prices[0].Timestamp != 1704153600 {
+		t.Errorf("first bar is at %s", prices[0].Time)
+	}
+
+	noAdjClose := "Date,Open,High,Low,Close,Volume\n2024-01-02,1,2,0.5,1.5,10\n"
+	prices, err = ParseCSV(strings.NewReader(noAdjClose))
+	if err != nil || len(prices) != 1 || prices[0].AdjClosePrice != 1.5 {
+		t.Errorf("unexpected bars %+v, error %v", prices, err)
+	}
+
+	for _, invalid := range []string{
+		"Date,Open,High,Low,Volume\n",
+		"Date,Open,High,Low,Close,Volume\n2024-01-02,1,2,abc,1.5,10\n",
+		"Date,Open,High,Low,Close,Volume\n02/01/2024,1,2,0.5,1.5,10\n",
+	} {
+		if _, err := ParseCSV(strings.NewReader(invalid)); err == nil {
+			t.Errorf("no error for %q", invalid)
+		}
+	}
+}
+
+func TestParseCSVInLocation(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip(err)
+	}
+	prices, err := ParseCSVInLocation(strings.NewReader(testCSV), newYork)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(prices) != 4 || prices[0].Time.Location() != newYork || prices[0].Time.Hour() != 0 || prices[0].Timestamp != 1704171600 {
+		t.Fatalf("unexpected bars %+v", prices)
+	}
+
+	intraday := "Date,Open,High,Low,Close,Volume\n2024-01-02 09:30:00-05:00,1,2,0.5,1.5,10\n"
+	prices, err = ParseCSVInLocation(strings.NewReader(intraday), newYork)
+	if err != nil || len(prices) != 1 || prices[0].Time.Location() != newYork || prices[0].Time.Hour() != 9 || prices[0].Timestamp != 1704205800 {
+		t.Errorf("unexpected bars %+v, error %v", prices, err)
+	}
+}
+
+func TestGetQuoteCSV(t *testing.T) {
+	var gotPath string
+	client := newTestClient(t, func(ctx *fasthttp.RequestCtx) {
+		if string(ctx.Path()) == "/v1/test/getcrumb" {
+			ctx.SetBodyString("crumb")
+			return
+		}
+		gotPath = string(ctx.Path())
+		ctx.SetBodyString(testCSV)
+	}, WithMissingBars(MissingBarsDrop))
+
+	quote, err := client.GetQuoteCSV(context.Background(), "AAPL", IntervalOneDay, PeriodFiveDays)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if gotPath != "/v7/finance/download/AAPL" {
+		t.Errorf("requested %s", gotPath)
+	}
+	if quote.Ticker != "AAPL" || quote.Interval != IntervalOneDay || quote.PriceRangeStart == 0 || len(quote.PriceHistoric) != 3 {
+		t.Errorf("unexpected quote %+v", quote)
+	}
+}
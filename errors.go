@@ -0,0 +1,91 @@
+package goyfinance
+
+import (
+	"errors"
+	"fmt"
+	"github.com/valyala/fasthttp"
+	"strings"
+)
+
+// Sentinel errors, to be used with errors.Is.
+// The errors returned by the library wrap them when they apply,
+// for example errors.Is(err, ErrTickerNotFound) for an unknown ticker.
+var (
+	ErrTickerNotFound  = errors.New("goyfinance: ticker not found")
+	ErrRateLimited     = errors.New("goyfinance: rate limited")
+	ErrUnauthorized    = errors.New("goyfinance: unauthorized")
+	ErrNoData          = errors.New("goyfinance: no data")
+	ErrInvalidRange    = errors.New("goyfinance: invalid range")
+	ErrInvalidInterval = errors.New("goyfinance: invalid interval")
+)
+
+// APIError is the error payload Yahoo Finance sends instead of data,
+// along with the HTTP status code of the response.
+// It matches the sentinel errors with errors.Is according to its code.
+type APIError struct {
+	StatusCode  int
+	Code        string // For example "Not Found"
+	Description string // For example "No data found, symbol may be delisted"
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("goyfinance: Yahoo Finance error (HTTP %d): %s: %s", e.StatusCode, e.Code, e.Description)
+}
+
+func (e *APIError) Is(target error) bool {
+	switch strings.ToLower(e.Code) {
+	case "not found":
+		return target == ErrTickerNotFound
+	case "unauthorized", "forbidden":
+		return target == ErrUnauthorized
+	case "too many requests":
+		return target == ErrRateLimited
+	case "bad request", "unprocessable entity":
+		return invalidRequestIs(e.Description, target)
+	}
+	return statusIs(e.StatusCode, target)
+}
+
+// invalidRequestIs tells whether a Bad Request or Unprocessable Entity error
+// with description matches the sentinel error target.
+// The chart endpoint rejects an interval it does not accept with a description like
+// "Invalid input - interval=4h is not supported. Valid intervals: [1m, 2m, ...]",
+// and a range it does not serve, like a start date after the end date
+// or 1m data older than 30 days, with any other description.
+func invalidRequestIs(description string, target error) bool {
+	description = strings.ToLower(description)
+	if strings.Contains(description, "interval=") || strings.Contains(description, "valid intervals") {
+		return target == ErrInvalidInterval
+	}
+	return target == ErrInvalidRange
+}
+
+// StatusError is returned when Yahoo Finance answers
+// with an unexpected HTTP status code.
+// It matches the sentinel errors with errors.Is according to its status code.
+type StatusError struct {
+	StatusCode int
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("goyfinance: unexpected HTTP status %d %s", e.StatusCode, fasthttp.StatusMessage(e.StatusCode))
+}
+
+func (e *StatusError) Is(target error) bool {
+	return statusIs(e.StatusCode, target)
+}
+
+// statusIs tells whether a response with statusCode matches the sentinel error target.
+func statusIs(statusCode int, target error) bool {
+	switch statusCode {
+	case fasthttp.StatusNotFound:
+		return target == ErrTickerNotFound
+	case fasthttp.StatusUnauthorized, fasthttp.StatusForbidden:
+		return target == ErrUnauthorized
+	case fasthttp.StatusTooManyRequests:
+		return target == ErrRateLimited
+	case fasthttp.StatusBadRequest, fasthttp.StatusUnprocessableEntity:
+		return target == ErrInvalidRange
+	}
+	return false
+}
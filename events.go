@@ -0,0 +1,49 @@
+package goyfinance
+
+import (
+	"context"
+)
+
+// GetDividends returns the dividends paid on a ticker during period,
+// in chronological order.
+func (c *Client) GetDividends(ctx context.Context, ticker string, period Range) ([]Dividend, error) {
+	quote, err := c.GetQuote(ctx, ticker, IntervalOneDay, period)
+	if err != nil {
+		return nil, err
+	}
+	return quote.Dividends, nil
+}
+
+// GetDividends returns the dividends paid on a ticker during period,
+// in chronological order, using the default client.
+func GetDividends(ticker string, period Range) ([]Dividend, error) {
+	return GetDividendsCtx(context.Background(), ticker, period)
+}
+
+// GetDividendsCtx is like GetDividends but takes a context
+// to cancel the request or set its deadline.
+func GetDividendsCtx(ctx context.Context, ticker string, period Range) ([]Dividend, error) {
+	return defaultClient.GetDividends(ctx, ticker, period)
+}
+
+// GetSplits returns the stock splits of a ticker during period,
+// in chronological order.
+func (c *Client) GetSplits(ctx context.Context, ticker string, period Range) ([]Split, error) {
+	quote, err := c.GetQuote(ctx, ticker, IntervalOneDay, period)
+	if err != nil {
+		return nil, err
+	}
+	return quote.Splits, nil
+}
+
+// GetSplits returns the stock splits of a ticker during period,
+// in chronological order, using the default client.
+func GetSplits(ticker string, period Range) ([]Split, error) {
+	return GetSplitsCtx(context.Background(), ticker, period)
+}
+
+// GetSplitsCtx is like GetSplits but takes a context
+// to cancel the request or set its deadline.
+func GetSplitsCtx(ctx context.Context, ticker string, period Range) ([]Split, error) {
+	return defaultClient.GetSplits(ctx, ticker, period)
+}
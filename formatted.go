@@ -0,0 +1,93 @@
+package goyfinance
+
+import (
+	"github.com/mailru/easyjson/jlexer"
+	"github.com/mailru/easyjson/jwriter"
+	"time"
+)
+
+// FormattedValue is a number of the quoteSummary endpoint,
+// which Yahoo sends as {"raw": 2.9e12, "fmt": "2.9T", "longFmt": "2,900,000,000,000"}.
+// A value Yahoo does not have is sent as {}, and decoded as the zero value.
+// A plain number is also accepted, in which case Fmt and LongFmt are empty.
+// It is decoded by hand because of that.
+type FormattedValue struct {
+	Raw     float64
+	Fmt     string // For example "2.9T" or "2024-02-09" for a date
+	LongFmt string // For example "2,900,000,000,000", empty for percentages and dates
+}
+
+// Time returns the date a FormattedValue holds as a unix timestamp,
+// like an ex-dividend date, or the zero time if it is empty.
+func (v FormattedValue) Time() time.Time {
+	if v.Raw == 0 {
+		return time.Time{}
+	}
+	return time.Unix(int64(v.Raw), 0).UTC()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *FormattedValue) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	if in.IsNull() {
+		in.Skip()
+		return
+	}
+	if !in.IsDelim('{') {
+		v.Raw = in.Float64()
+		return
+	}
+
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "raw":
+			v.Raw = in.Float64()
+		case "fmt":
+			v.Fmt = in.String()
+		case "longFmt":
+			v.LongFmt = in.String()
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v FormattedValue) MarshalEasyJSON(out *jwriter.Writer) {
+	if v.Fmt == "" && v.LongFmt == "" && v.Raw == 0 {
+		out.RawString("{}")
+		return
+	}
+	out.RawString(`{"raw":`)
+	out.Float64(v.Raw)
+	out.RawString(`,"fmt":`)
+	out.String(v.Fmt)
+	if v.LongFmt != "" {
+		out.RawString(`,"longFmt":`)
+		out.String(v.LongFmt)
+	}
+	out.RawByte('}')
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *FormattedValue) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	v.UnmarshalEasyJSON(&r)
+	return r.Error()
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v FormattedValue) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	v.MarshalEasyJSON(&w)
+	return w.Buffer.BuildBytes(), w.Error
+}
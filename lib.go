@@ -1,7 +1,12 @@
 package goyfinance
 
 import (
+	"fmt"
 	"github.com/mailru/easyjson"
+	"github.com/mailru/easyjson/opt"
+	"github.com/valyala/fasthttp"
+	"sort"
+	"sync"
 	"time"
 )
 
@@ -10,39 +15,13 @@ import (
 // exported and are only used
 // internally by the library
 
-// / Gets a unix timestamp for now and for `period` days/mo/years in the past
-// / The first timestamp returned is `period` days/mo/years ago and the second period is now
-func getUnixTimestamps(period Period) (int64, int64) {
-	var pastPeriod time.Time
-	var now time.Time
-
-	now = time.Now()
-	switch period {
-	case PeriodFiveDays:
-		pastPeriod = now.AddDate(0, 0, -5)
-	case PeriodOneMonth:
-		pastPeriod = now.AddDate(0, -1, 0)
-	case PeriodThreeMonth:
-		pastPeriod = now.AddDate(0, -3, 0)
-	case PeriodSixMonth:
-		pastPeriod = now.AddDate(0, -6, 0)
-	case PeriodOneYear:
-		pastPeriod = now.AddDate(-1, 0, 0)
-	case PeriodTwoYears:
-		pastPeriod = now.AddDate(-2, 0, 0)
-	case PeriodFiveYear:
-		pastPeriod = now.AddDate(-5, 0, 0)
-	case PeriodTenYears:
-		pastPeriod = now.AddDate(-10, 0, 0)
-	case PeriodYtd:
-		pastPeriod = time.Date(now.Year(), 1, 1, 0, 0, 0, 0, now.Location())
-	default:
-		// Unreachable code but return 69, 420 because I'm so funny
-		return 69, 420
-	}
-
-	// Convert the time.Time objects to unix timestamps
-	return pastPeriod.Unix(), now.Unix()
+// getUnixTimestamps returns the unix timestamps
+// of the start and the end of period, relative to now.
+func getUnixTimestamps(period Range) (int64, int64, error) {
+	if period == nil {
+		return 0, 0, fmt.Errorf("goyfinance: no period given: %w", ErrInvalidRange)
+	}
+	return period.timestamps(time.Now())
 }
 
 func parseJSONToJSONQuote(jsonData []byte) (JSONQuote, error) {
@@ -61,23 +40,222 @@ func parseJSONtoQuote(jsonData []byte, ticker string, period1 int64, period2 int
 }
 
 func parseJSONQuoteToQuote(jsonQuote JSONQuote, ticker string, period1 int64, period2 int64) (Quote, error) {
+	if jsonQuote.Chart.Error != nil {
+		return Quote{}, &APIError{StatusCode: fasthttp.StatusOK, Code: jsonQuote.Chart.Error.Code, Description: jsonQuote.Chart.Error.Description}
+	}
+	if len(jsonQuote.Chart.Result) == 0 {
+		return Quote{}, fmt.Errorf("goyfinance: %s: %w", ticker, ErrNoData)
+	}
+	result := jsonQuote.Chart.Result[0]
+
 	var quote Quote
 	quote.Ticker = ticker
 	quote.PriceRangeStart = period1
 	quote.PriceRangeEnd = period2
-	quote.Interval = Interval(jsonQuote.Chart.Result[0].Meta.DataGranularity)
-	for i := 0; i < len(jsonQuote.Chart.Result[0].Timestamp); i++ {
+	quote.Interval = Interval(result.Meta.DataGranularity)
+	quote.ServedRange = Period(result.Meta.Range)
+	for _, validRange := range result.Meta.ValidRanges {
+		quote.ValidRanges = append(quote.ValidRanges, Period(validRange))
+	}
+	location := exchangeLocation(result.Meta.ExchangeTimezoneName, result.Meta.Timezone, result.Meta.Gmtoffset)
+	quote.Meta = QuoteMeta{
+		Currency:             result.Meta.Currency,
+		Symbol:               result.Meta.Symbol,
+		ExchangeName:         result.Meta.ExchangeName,
+		InstrumentType:       result.Meta.InstrumentType,
+		Timezone:             result.Meta.Timezone,
+		ExchangeTimezoneName: result.Meta.ExchangeTimezoneName,
+		Location:             location,
+		GMTOffset:            time.Duration(result.Meta.Gmtoffset) * time.Second,
+		RegularMarketPrice:   result.Meta.RegularMarketPrice,
+		ChartPreviousClose:   result.Meta.ChartPreviousClose,
+		PreviousClose:        result.Meta.PreviousClose,
+		Scale:                result.Meta.Scale,
+		PriceHint:            result.Meta.PriceHint,
+		CurrentTradingPeriod: CurrentTradingPeriod{
+			Pre:     parseTradingPeriod(result.Meta.CurrentTradingPeriod.Pre, location),
+			Regular: parseTradingPeriod(result.Meta.CurrentTradingPeriod.Regular, location),
+			Post:    parseTradingPeriod(result.Meta.CurrentTradingPeriod.Post, location),
+		},
+	}
+	if result.Meta.FirstTradeDate != 0 {
+		quote.Meta.FirstTradeDate = time.Unix(int64(result.Meta.FirstTradeDate), 0).In(location)
+	}
+	if result.Meta.RegularMarketTime != 0 {
+		quote.Meta.RegularMarketTime = time.Unix(int64(result.Meta.RegularMarketTime), 0).In(location)
+	}
+	quote.Meta.TradingPeriods = parseTradingPeriodDays(result.Meta.TradingPeriods.Regular, location)
+	quote.Meta.PreMarketPeriods = parseTradingPeriodDays(result.Meta.TradingPeriods.Pre, location)
+	quote.Meta.PostMarketPeriods = parseTradingPeriodDays(result.Meta.TradingPeriods.Post, location)
+
+	for _, dividend := range result.Events.Dividends {
+		quote.Dividends = append(quote.Dividends, Dividend{
+			Time:      time.Unix(dividend.Date, 0).In(location),
+			Timestamp: dividend.Date,
+			Amount:    dividend.Amount,
+		})
+	}
+	sort.Slice(quote.Dividends, func(i, j int) bool { return quote.Dividends[i].Timestamp < quote.Dividends[j].Timestamp })
+	for _, split := range result.Events.Splits {
+		quote.Splits = append(quote.Splits, Split{
+			Time:        time.Unix(split.Date, 0).In(location),
+			Timestamp:   split.Date,
+			Numerator:   split.Numerator,
+			Denominator: split.Denominator,
+			Ratio:       split.SplitRatio,
+		})
+	}
+	sort.Slice(quote.Splits, func(i, j int) bool { return quote.Splits[i].Timestamp < quote.Splits[j].Timestamp })
+	for _, capitalGain := range result.Events.CapitalGains {
+		quote.CapitalGains = append(quote.CapitalGains, CapitalGain{
+			Time:      time.Unix(capitalGain.Date, 0).In(location),
+			Timestamp: capitalGain.Date,
+			Amount:    capitalGain.Amount,
+		})
+	}
+	sort.Slice(quote.CapitalGains, func(i, j int) bool { return quote.CapitalGains[i].Timestamp < quote.CapitalGains[j].Timestamp })
+
+	if len(result.Timestamp) == 0 {
+		return quote, nil
+	}
+	if len(result.Indicators.Quote) == 0 {
+		return Quote{}, fmt.Errorf("goyfinance: %s: %w", ticker, ErrNoData)
+	}
+	indicators := result.Indicators.Quote[0]
+	if len(indicators.Open) < len(result.Timestamp) ||
+		len(indicators.Low) < len(result.Timestamp) ||
+		len(indicators.High) < len(result.Timestamp) ||
+		len(indicators.Close) < len(result.Timestamp) ||
+		len(indicators.Volume) < len(result.Timestamp) {
+		return Quote{}, fmt.Errorf("goyfinance: %s: fewer prices than timestamps: %w", ticker, ErrNoData)
+	}
+
+	for i := 0; i < len(result.Timestamp); i++ {
 		var priceData PriceData
-		priceData.OpenPrice = jsonQuote.Chart.Result[0].Indicators.Quote[0].Open[i]
-		priceData.LowPrice = jsonQuote.Chart.Result[0].Indicators.Quote[0].Low[i]
-		priceData.HighPrice = jsonQuote.Chart.Result[0].Indicators.Quote[0].High[i]
-		priceData.ClosePrice = jsonQuote.Chart.Result[0].Indicators.Quote[0].Close[i]
-		priceData.Volume = jsonQuote.Chart.Result[0].Indicators.Quote[0].Volume[i]
+		priceData.Timestamp = int64(result.Timestamp[i])
+		priceData.Time = time.Unix(priceData.Timestamp, 0).In(location)
+		priceData.Session = SessionRegular
+		if isIntraday(quote.Interval) {
+			priceData.Session = sessionOf(priceData.Time, quote.Meta)
+		}
+		priceData.OpenPrice = indicators.Open[i].V
+		priceData.LowPrice = indicators.Low[i].V
+		priceData.HighPrice = indicators.High[i].V
+		priceData.ClosePrice = indicators.Close[i].V
+		priceData.Volume = indicators.Volume[i].V
+		priceData.AdjClosePrice = priceData.ClosePrice
+		if len(result.Indicators.Adjclose) > 0 && i < len(result.Indicators.Adjclose[0].Adjclose) && result.Indicators.Adjclose[0].Adjclose[i].Defined {
+			priceData.AdjClosePrice = result.Indicators.Adjclose[0].Adjclose[i].V
+		}
+		priceData.Missing = !indicators.Open[i].Defined ||
+			!indicators.Low[i].Defined ||
+			!indicators.High[i].Defined ||
+			!indicators.Close[i].Defined ||
+			!indicators.Volume[i].Defined
 		quote.PriceHistoric = append(quote.PriceHistoric, priceData)
 	}
+
+	// Yahoo computed the range, so it is the one of the bars.
+	if period1 == 0 && period2 == 0 {
+		quote.PriceRangeStart = quote.PriceHistoric[0].Timestamp
+		quote.PriceRangeEnd = quote.PriceHistoric[len(quote.PriceHistoric)-1].Timestamp
+	}
 	return quote, nil
 }
 
+// fillMissingBars applies policy to the missing bars of prices.
+func fillMissingBars(prices []PriceData, policy MissingBarPolicy) []PriceData {
+	switch policy {
+	case MissingBarsDrop:
+		kept := prices[:0]
+		for _, priceData := range prices {
+			if !priceData.Missing {
+				kept = append(kept, priceData)
+			}
+		}
+		return kept
+	case MissingBarsForwardFill:
+		for i := 1; i < len(prices); i++ {
+			if prices[i].Missing && prices[i-1].ClosePrice != 0 {
+				previousClose := prices[i-1].ClosePrice
+				prices[i].OpenPrice = previousClose
+				prices[i].LowPrice = previousClose
+				prices[i].HighPrice = previousClose
+				prices[i].ClosePrice = previousClose
+				prices[i].AdjClosePrice = prices[i-1].AdjClosePrice
+				prices[i].Volume = 0
+			}
+		}
+	}
+	return prices
+}
+
+// parseTradingPeriod converts a trading period from the chart metadata.
+func parseTradingPeriod(tradingPeriod JSONTradingPeriod, location *time.Location) TradingPeriod {
+	if tradingPeriod.Start == 0 && tradingPeriod.End == 0 {
+		return TradingPeriod{}
+	}
+	return TradingPeriod{
+		Start: time.Unix(int64(tradingPeriod.Start), 0).In(location),
+		End:   time.Unix(int64(tradingPeriod.End), 0).In(location),
+	}
+}
+
+// parseTradingPeriodDays converts days of trading periods from the chart metadata
+// into a single slice.
+func parseTradingPeriodDays(days [][]JSONTradingPeriod, location *time.Location) []TradingPeriod {
+	var tradingPeriods []TradingPeriod
+	for _, day := range days {
+		for _, tradingPeriod := range day {
+			tradingPeriods = append(tradingPeriods, parseTradingPeriod(tradingPeriod, location))
+		}
+	}
+	return tradingPeriods
+}
+
+// locations caches the exchange timezones by name,
+// because time.LoadLocation reads the timezone database on every call.
+var locations sync.Map
+
+// exchangeLocation returns the timezone of an exchange from the chart metadata.
+// name is an IANA timezone like "America/New_York".
+// If it is unknown, a fixed zone named abbreviation with gmtOffset seconds is returned.
+func exchangeLocation(name string, abbreviation string, gmtOffset int) *time.Location {
+	if location, ok := locations.Load(name); ok {
+		return location.(*time.Location)
+	}
+	if name != "" {
+		if location, err := time.LoadLocation(name); err == nil {
+			locations.Store(name, location)
+			return location
+		}
+	}
+	return time.FixedZone(abbreviation, gmtOffset)
+}
+
+// parseErrorResponse returns the error of a response
+// with a status code other than 200 OK.
+// It is an *APIError if the body holds an error payload
+// and a *StatusError otherwise.
+func parseErrorResponse(statusCode int, body []byte) error {
+	var errResp jsonErrorResponse
+	if easyjson.Unmarshal(body, &errResp) == nil {
+		// Each endpoint sends its error under its own name.
+		for _, jsonErr := range []*JSONError{
+			errResp.Chart.Error,
+			errResp.Finance.Error,
+			errResp.QuoteResponse.Error,
+			errResp.QuoteSummary.Error,
+			errResp.OptionChain.Error,
+		} {
+			if jsonErr != nil {
+				return &APIError{StatusCode: statusCode, Code: jsonErr.Code, Description: jsonErr.Description}
+			}
+		}
+	}
+	return &StatusError{StatusCode: statusCode}
+}
+
 // ---- Structs definitions ----
 // Structs are used to parse the
 // JSON data returned by the
@@ -106,71 +284,216 @@ type JSONQuote struct {
 				Scale                int     `json:"scale"`
 				PriceHint            int     `json:"priceHint"`
 				CurrentTradingPeriod struct {
-					Pre struct {
-						Timezone  string `json:"timezone"`
-						Start     int    `json:"start"`
-						End       int    `json:"end"`
-						Gmtoffset int    `json:"gmtoffset"`
-					} `json:"pre"`
-					Regular struct {
-						Timezone  string `json:"timezone"`
-						Start     int    `json:"start"`
-						End       int    `json:"end"`
-						Gmtoffset int    `json:"gmtoffset"`
-					} `json:"regular"`
-					Post struct {
-						Timezone  string `json:"timezone"`
-						Start     int    `json:"start"`
-						End       int    `json:"end"`
-						Gmtoffset int    `json:"gmtoffset"`
-					} `json:"post"`
+					Pre     JSONTradingPeriod `json:"pre"`
+					Regular JSONTradingPeriod `json:"regular"`
+					Post    JSONTradingPeriod `json:"post"`
 				} `json:"currentTradingPeriod"`
-				TradingPeriods [][]struct {
-					Timezone  string `json:"timezone"`
-					Start     int    `json:"start"`
-					End       int    `json:"end"`
-					Gmtoffset int    `json:"gmtoffset"`
-				} `json:"tradingPeriods"`
-				DataGranularity string   `json:"dataGranularity"`
-				Range           string   `json:"range"`
-				ValidRanges     []string `json:"validRanges"`
+				TradingPeriods  JSONTradingPeriods `json:"tradingPeriods"`
+				DataGranularity string             `json:"dataGranularity"`
+				Range           string             `json:"range"`
+				ValidRanges     []string           `json:"validRanges"`
 			} `json:"meta"`
-			Timestamp  []int `json:"timestamp"`
+			Timestamp []int `json:"timestamp"`
+			// Corporate actions keyed by their Unix timestamp as a string.
+			Events struct {
+				Dividends map[string]struct {
+					Amount float64 `json:"amount"`
+					Date   int64   `json:"date"`
+				} `json:"dividends"`
+				Splits map[string]struct {
+					Date        int64   `json:"date"`
+					Numerator   float64 `json:"numerator"`
+					Denominator float64 `json:"denominator"`
+					SplitRatio  string  `json:"splitRatio"`
+				} `json:"splits"`
+				CapitalGains map[string]struct {
+					Amount float64 `json:"amount"`
+					Date   int64   `json:"date"`
+				} `json:"capitalGains"`
+			} `json:"events"`
 			Indicators struct {
+				// Yahoo sends null for the prices of halted or incomplete bars,
+				// which are left undefined.
 				Quote []struct {
-					Open   []float64 `json:"open"`
-					Low    []float64 `json:"low"`
-					Volume []int     `json:"volume"`
-					High   []float64 `json:"high"`
-					Close  []float64 `json:"close"`
+					Open   []opt.Float64 `json:"open"`
+					Low    []opt.Float64 `json:"low"`
+					Volume []opt.Int     `json:"volume"`
+					High   []opt.Float64 `json:"high"`
+					Close  []opt.Float64 `json:"close"`
 				} `json:"quote"`
+				// The close adjusted for splits and dividends,
+				// only sent for daily and longer intervals.
+				Adjclose []struct {
+					Adjclose []opt.Float64 `json:"adjclose"`
+				} `json:"adjclose"`
 			} `json:"indicators"`
 		} `json:"result"`
-		Error any `json:"error"`
+		Error *JSONError `json:"error"`
+	} `json:"chart"`
+}
+
+// JSONTradingPeriod is a trading session of an exchange,
+// between two unix timestamps.
+type JSONTradingPeriod struct {
+	Timezone  string `json:"timezone"`
+	Start     int    `json:"start"`
+	End       int    `json:"end"`
+	Gmtoffset int    `json:"gmtoffset"`
+}
+
+// JSONError is the error payload Yahoo Finance sends instead of data,
+// for example {"code":"Not Found","description":"No data found, symbol may be delisted"}.
+// It is returned to callers as an *APIError.
+type JSONError struct {
+	Code        string `json:"code"`
+	Description string `json:"description"`
+}
+
+// jsonErrorResponse holds the error payload of any endpoint,
+// which is under "chart" for the chart endpoint and under "finance" for the others.
+type jsonErrorResponse struct {
+	Chart struct {
+		Error *JSONError `json:"error"`
 	} `json:"chart"`
+	Finance struct {
+		Error *JSONError `json:"error"`
+	} `json:"finance"`
+	QuoteResponse struct {
+		Error *JSONError `json:"error"`
+	} `json:"quoteResponse"`
+	QuoteSummary struct {
+		Error *JSONError `json:"error"`
+	} `json:"quoteSummary"`
+	OptionChain struct {
+		Error *JSONError `json:"error"`
+	} `json:"optionChain"`
 }
 
 // One interval of price data
 // for a ticker.
-// Contains OHLVC data
+// Contains the start of the interval and OHLVC data
 type PriceData struct {
+	Time       time.Time // Start of the interval, in the timezone of the exchange
+	Timestamp  int64     // Unix timestamp of the start of the interval
 	OpenPrice  float64
 	LowPrice   float64
 	HighPrice  float64
 	ClosePrice float64
-	Volume     int
+	// The close adjusted for splits and dividends.
+	// It is the same as ClosePrice for intraday intervals,
+	// for which Yahoo does not send it.
+	AdjClosePrice float64
+	Volume        int
+	Missing       bool    // Yahoo sent no data for the interval, see MissingBarPolicy
+	Session       Session // Always SessionRegular for daily and longer intervals
 }
 
 // Quote is a single quote for a ticker
 // Contains the ticker, the
-// price range, the interval
-// and the price data
+// price range, the interval,
+// the price data and the
+// corporate actions in the range
 type Quote struct {
 	Ticker          string
 	PriceRangeStart int64 // Unix timestamp of the start of the price range
 	PriceRangeEnd   int64 // Unix timestamp of the end of the price range
 	Interval        Interval
+	Meta            QuoteMeta
+	ServedRange     Period   // The range Yahoo served, only set for a NativeRange
+	ValidRanges     []Period // The ranges Yahoo serves for the ticker
 	PriceHistoric   []PriceData
+	Dividends       []Dividend    // In chronological order
+	Splits          []Split       // In chronological order
+	CapitalGains    []CapitalGain // In chronological order, only for funds
+}
+
+// QuoteMeta is the metadata of a quote,
+// like the currency and the exchange of the ticker.
+// Times are in the timezone of the exchange.
+type QuoteMeta struct {
+	Currency             string // For example "USD", or "GBp" for pence sterling
+	Symbol               string
+	ExchangeName         string // For example "NMS" or "LSE"
+	InstrumentType       string // For example "EQUITY" or "ETF"
+	Timezone             string // Abbreviation of the timezone of the exchange, for example "EST"
+	ExchangeTimezoneName string // IANA name of the timezone of the exchange, for example "America/New_York"
+	// Timezone of the exchange.
+	// If ExchangeTimezoneName is unknown, it is a fixed zone with GMTOffset.
+	Location           *time.Location `json:"-"`
+	GMTOffset          time.Duration  // Offset of the timezone of the exchange from UTC
+	FirstTradeDate     time.Time
+	RegularMarketTime  time.Time // Time of RegularMarketPrice
+	RegularMarketPrice float64
+	ChartPreviousClose float64 // Close before the start of the price range
+	PreviousClose      float64 // Close of the previous day, not sent for all ranges
+	Scale              int
+	PriceHint          int // Number of decimals prices should be shown with
+	// Trading sessions of the current day.
+	CurrentTradingPeriod CurrentTradingPeriod
+	// Regular trading sessions of the days of the price range,
+	// in chronological order, only sent for intraday intervals.
+	TradingPeriods []TradingPeriod
+	// Pre-market and post-market sessions of the days of the price range,
+	// only sent when extended hours are included, see WithPrePost.
+	PreMarketPeriods  []TradingPeriod
+	PostMarketPeriods []TradingPeriod
+}
+
+// CurrentTradingPeriod is the trading sessions of an exchange for a day.
+type CurrentTradingPeriod struct {
+	Pre     TradingPeriod // Pre-market session
+	Regular TradingPeriod // Regular session
+	Post    TradingPeriod // Post-market session
+}
+
+// TradingPeriod is a trading session of an exchange.
+type TradingPeriod struct {
+	Start time.Time
+	End   time.Time
+}
+
+// Dividend is a cash dividend paid on a ticker.
+type Dividend struct {
+	Time      time.Time // Ex-dividend date, in the timezone of the exchange
+	Timestamp int64     // Unix timestamp of the ex-dividend date
+	Amount    float64   // Amount per share, in the currency of the ticker
+}
+
+// Split is a stock split of a ticker.
+// A 4:1 split has a Numerator of 4 and a Denominator of 1.
+type Split struct {
+	Time        time.Time // Date of the split, in the timezone of the exchange
+	Timestamp   int64     // Unix timestamp of the date of the split
+	Numerator   float64
+	Denominator float64
+	Ratio       string // For example "4:1"
+}
+
+// CapitalGain is a capital gain distributed by a fund.
+type CapitalGain struct {
+	Time      time.Time // Date of the distribution, in the timezone of the exchange
+	Timestamp int64     // Unix timestamp of the date of the distribution
+	Amount    float64   // Amount per share, in the currency of the ticker
+}
+
+// Adjusted returns a copy of the quote with its open, low, high and close
+// prices adjusted for splits and dividends, using the ratio of
+// AdjClosePrice to ClosePrice of each bar.
+// Volumes are left as they are, since Yahoo already adjusts them for splits.
+func (q Quote) Adjusted() Quote {
+	adjusted := q
+	adjusted.PriceHistoric = make([]PriceData, len(q.PriceHistoric))
+	for i, priceData := range q.PriceHistoric {
+		if priceData.ClosePrice != 0 && priceData.AdjClosePrice != 0 {
+			ratio := priceData.AdjClosePrice / priceData.ClosePrice
+			priceData.OpenPrice *= ratio
+			priceData.LowPrice *= ratio
+			priceData.HighPrice *= ratio
+			priceData.ClosePrice = priceData.AdjClosePrice
+		}
+		adjusted.PriceHistoric[i] = priceData
+	}
+	return adjusted
 }
 
 // ---- Enum definitions ----
@@ -200,9 +523,11 @@ const (
 	IntervalThreeMonths    Interval = "3mo"
 )
 
+// Period is a Range ending now, like the last five days.
 type Period string
 
 const (
+	PeriodOneDay     Period = "1d"
 	PeriodFiveDays   Period = "5d"
 	PeriodOneMonth   Period = "1mo"
 	PeriodThreeMonth Period = "3mo"
@@ -212,4 +537,19 @@ const (
 	PeriodFiveYear   Period = "5y"
 	PeriodTenYears   Period = "10y"
 	PeriodYtd        Period = "ytd"
+	PeriodMax        Period = "max"
+)
+
+// MissingBarPolicy tells what to do with the bars
+// Yahoo sent no data for, like halted or incomplete intervals.
+type MissingBarPolicy string
+
+const (
+	// MissingBarsKeep keeps missing bars with zero prices and Missing set.
+	MissingBarsKeep MissingBarPolicy = "keep"
+	// MissingBarsDrop removes missing bars.
+	MissingBarsDrop MissingBarPolicy = "drop"
+	// MissingBarsForwardFill sets the prices of missing bars
+	// to the previous close, with a zero volume and Missing set.
+	MissingBarsForwardFill MissingBarPolicy = "ffill"
 )
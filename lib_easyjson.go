@@ -7,6 +7,8 @@ import (
 	easyjson "github.com/mailru/easyjson"
 	jlexer "github.com/mailru/easyjson/jlexer"
 	jwriter "github.com/mailru/easyjson/jwriter"
+	opt "github.com/mailru/easyjson/opt"
+	time "time"
 )
 
 // suppress unused package warning
@@ -17,7 +19,617 @@ var (
 	_ easyjson.Marshaler
 )
 
-func easyjsonEc607727DecodeGithubComZeteliasGoyfinance(in *jlexer.Lexer, out *Quote) {
+func easyjsonEc607727DecodeGithubComZeteliasGoyfinance(in *jlexer.Lexer, out *jsonErrorResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "chart":
+			easyjsonEc607727Decode(in, &out.Chart)
+		case "finance":
+			easyjsonEc607727Decode(in, &out.Finance)
+		case "quoteResponse":
+			easyjsonEc607727Decode(in, &out.QuoteResponse)
+		case "quoteSummary":
+			easyjsonEc607727Decode(in, &out.QuoteSummary)
+		case "optionChain":
+			easyjsonEc607727Decode(in, &out.OptionChain)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonEc607727EncodeGithubComZeteliasGoyfinance(out *jwriter.Writer, in jsonErrorResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"chart\":"
+		out.RawString(prefix[1:])
+		easyjsonEc607727Encode(out, in.Chart)
+	}
+	{
+		const prefix string = ",\"finance\":"
+		out.RawString(prefix)
+		easyjsonEc607727Encode(out, in.Finance)
+	}
+	{
+		const prefix string = ",\"quoteResponse\":"
+		out.RawString(prefix)
+		easyjsonEc607727Encode(out, in.QuoteResponse)
+	}
+	{
+		const prefix string = ",\"quoteSummary\":"
+		out.RawString(prefix)
+		easyjsonEc607727Encode(out, in.QuoteSummary)
+	}
+	{
+		const prefix string = ",\"optionChain\":"
+		out.RawString(prefix)
+		easyjsonEc607727Encode(out, in.OptionChain)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v jsonErrorResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonEc607727EncodeGithubComZeteliasGoyfinance(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v jsonErrorResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonEc607727EncodeGithubComZeteliasGoyfinance(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *jsonErrorResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonEc607727DecodeGithubComZeteliasGoyfinance(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *jsonErrorResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonEc607727DecodeGithubComZeteliasGoyfinance(l, v)
+}
+func easyjsonEc607727Decode(in *jlexer.Lexer, out *struct {
+	Error *JSONError `json:"error"`
+}) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "error":
+			if in.IsNull() {
+				in.Skip()
+				out.Error = nil
+			} else {
+				if out.Error == nil {
+					out.Error = new(JSONError)
+				}
+				(*out.Error).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonEc607727Encode(out *jwriter.Writer, in struct {
+	Error *JSONError `json:"error"`
+}) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"error\":"
+		out.RawString(prefix[1:])
+		if in.Error == nil {
+			out.RawString("null")
+		} else {
+			(*in.Error).MarshalEasyJSON(out)
+		}
+	}
+	out.RawByte('}')
+}
+func easyjsonEc607727DecodeGithubComZeteliasGoyfinance1(in *jlexer.Lexer, out *TradingPeriod) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "Start":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Start).UnmarshalJSON(data))
+			}
+		case "End":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.End).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonEc607727EncodeGithubComZeteliasGoyfinance1(out *jwriter.Writer, in TradingPeriod) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Start\":"
+		out.RawString(prefix[1:])
+		out.Raw((in.Start).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"End\":"
+		out.RawString(prefix)
+		out.Raw((in.End).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v TradingPeriod) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonEc607727EncodeGithubComZeteliasGoyfinance1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v TradingPeriod) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonEc607727EncodeGithubComZeteliasGoyfinance1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *TradingPeriod) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonEc607727DecodeGithubComZeteliasGoyfinance1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *TradingPeriod) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonEc607727DecodeGithubComZeteliasGoyfinance1(l, v)
+}
+func easyjsonEc607727DecodeGithubComZeteliasGoyfinance2(in *jlexer.Lexer, out *Split) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "Time":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Time).UnmarshalJSON(data))
+			}
+		case "Timestamp":
+			out.Timestamp = int64(in.Int64())
+		case "Numerator":
+			out.Numerator = float64(in.Float64())
+		case "Denominator":
+			out.Denominator = float64(in.Float64())
+		case "Ratio":
+			out.Ratio = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonEc607727EncodeGithubComZeteliasGoyfinance2(out *jwriter.Writer, in Split) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Time\":"
+		out.RawString(prefix[1:])
+		out.Raw((in.Time).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"Timestamp\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Timestamp))
+	}
+	{
+		const prefix string = ",\"Numerator\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Numerator))
+	}
+	{
+		const prefix string = ",\"Denominator\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Denominator))
+	}
+	{
+		const prefix string = ",\"Ratio\":"
+		out.RawString(prefix)
+		out.String(string(in.Ratio))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Split) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonEc607727EncodeGithubComZeteliasGoyfinance2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Split) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonEc607727EncodeGithubComZeteliasGoyfinance2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Split) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonEc607727DecodeGithubComZeteliasGoyfinance2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Split) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonEc607727DecodeGithubComZeteliasGoyfinance2(l, v)
+}
+func easyjsonEc607727DecodeGithubComZeteliasGoyfinance3(in *jlexer.Lexer, out *QuoteMeta) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "Currency":
+			out.Currency = string(in.String())
+		case "Symbol":
+			out.Symbol = string(in.String())
+		case "ExchangeName":
+			out.ExchangeName = string(in.String())
+		case "InstrumentType":
+			out.InstrumentType = string(in.String())
+		case "Timezone":
+			out.Timezone = string(in.String())
+		case "ExchangeTimezoneName":
+			out.ExchangeTimezoneName = string(in.String())
+		case "GMTOffset":
+			out.GMTOffset = time.Duration(in.Int64())
+		case "FirstTradeDate":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.FirstTradeDate).UnmarshalJSON(data))
+			}
+		case "RegularMarketTime":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.RegularMarketTime).UnmarshalJSON(data))
+			}
+		case "RegularMarketPrice":
+			out.RegularMarketPrice = float64(in.Float64())
+		case "ChartPreviousClose":
+			out.ChartPreviousClose = float64(in.Float64())
+		case "PreviousClose":
+			out.PreviousClose = float64(in.Float64())
+		case "Scale":
+			out.Scale = int(in.Int())
+		case "PriceHint":
+			out.PriceHint = int(in.Int())
+		case "CurrentTradingPeriod":
+			(out.CurrentTradingPeriod).UnmarshalEasyJSON(in)
+		case "TradingPeriods":
+			if in.IsNull() {
+				in.Skip()
+				out.TradingPeriods = nil
+			} else {
+				in.Delim('[')
+				if out.TradingPeriods == nil {
+					if !in.IsDelim(']') {
+						out.TradingPeriods = make([]TradingPeriod, 0, 1)
+					} else {
+						out.TradingPeriods = []TradingPeriod{}
+					}
+				} else {
+					out.TradingPeriods = (out.TradingPeriods)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 TradingPeriod
+					(v1).UnmarshalEasyJSON(in)
+					out.TradingPeriods = append(out.TradingPeriods, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "PreMarketPeriods":
+			if in.IsNull() {
+				in.Skip()
+				out.PreMarketPeriods = nil
+			} else {
+				in.Delim('[')
+				if out.PreMarketPeriods == nil {
+					if !in.IsDelim(']') {
+						out.PreMarketPeriods = make([]TradingPeriod, 0, 1)
+					} else {
+						out.PreMarketPeriods = []TradingPeriod{}
+					}
+				} else {
+					out.PreMarketPeriods = (out.PreMarketPeriods)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v2 TradingPeriod
+					(v2).UnmarshalEasyJSON(in)
+					out.PreMarketPeriods = append(out.PreMarketPeriods, v2)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "PostMarketPeriods":
+			if in.IsNull() {
+				in.Skip()
+				out.PostMarketPeriods = nil
+			} else {
+				in.Delim('[')
+				if out.PostMarketPeriods == nil {
+					if !in.IsDelim(']') {
+						out.PostMarketPeriods = make([]TradingPeriod, 0, 1)
+					} else {
+						out.PostMarketPeriods = []TradingPeriod{}
+					}
+				} else {
+					out.PostMarketPeriods = (out.PostMarketPeriods)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v3 TradingPeriod
+					(v3).UnmarshalEasyJSON(in)
+					out.PostMarketPeriods = append(out.PostMarketPeriods, v3)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonEc607727EncodeGithubComZeteliasGoyfinance3(out *jwriter.Writer, in QuoteMeta) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Currency\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Currency))
+	}
+	{
+		const prefix string = ",\"Symbol\":"
+		out.RawString(prefix)
+		out.String(string(in.Symbol))
+	}
+	{
+		const prefix string = ",\"ExchangeName\":"
+		out.RawString(prefix)
+		out.String(string(in.ExchangeName))
+	}
+	{
+		const prefix string = ",\"InstrumentType\":"
+		out.RawString(prefix)
+		out.String(string(in.InstrumentType))
+	}
+	{
+		const prefix string = ",\"Timezone\":"
+		out.RawString(prefix)
+		out.String(string(in.Timezone))
+	}
+	{
+		const prefix string = ",\"ExchangeTimezoneName\":"
+		out.RawString(prefix)
+		out.String(string(in.ExchangeTimezoneName))
+	}
+	{
+		const prefix string = ",\"GMTOffset\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.GMTOffset))
+	}
+	{
+		const prefix string = ",\"FirstTradeDate\":"
+		out.RawString(prefix)
+		out.Raw((in.FirstTradeDate).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"RegularMarketTime\":"
+		out.RawString(prefix)
+		out.Raw((in.RegularMarketTime).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"RegularMarketPrice\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.RegularMarketPrice))
+	}
+	{
+		const prefix string = ",\"ChartPreviousClose\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.ChartPreviousClose))
+	}
+	{
+		const prefix string = ",\"PreviousClose\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.PreviousClose))
+	}
+	{
+		const prefix string = ",\"Scale\":"
+		out.RawString(prefix)
+		out.Int(int(in.Scale))
+	}
+	{
+		const prefix string = ",\"PriceHint\":"
+		out.RawString(prefix)
+		out.Int(int(in.PriceHint))
+	}
+	{
+		const prefix string = ",\"CurrentTradingPeriod\":"
+		out.RawString(prefix)
+		(in.CurrentTradingPeriod).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"TradingPeriods\":"
+		out.RawString(prefix)
+		if in.TradingPeriods == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v4, v5 := range in.TradingPeriods {
+				if v4 > 0 {
+					out.RawByte(',')
+				}
+				(v5).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"PreMarketPeriods\":"
+		out.RawString(prefix)
+		if in.PreMarketPeriods == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v6, v7 := range in.PreMarketPeriods {
+				if v6 > 0 {
+					out.RawByte(',')
+				}
+				(v7).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"PostMarketPeriods\":"
+		out.RawString(prefix)
+		if in.PostMarketPeriods == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v8, v9 := range in.PostMarketPeriods {
+				if v8 > 0 {
+					out.RawByte(',')
+				}
+				(v9).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v QuoteMeta) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonEc607727EncodeGithubComZeteliasGoyfinance3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v QuoteMeta) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonEc607727EncodeGithubComZeteliasGoyfinance3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *QuoteMeta) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonEc607727DecodeGithubComZeteliasGoyfinance3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *QuoteMeta) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonEc607727DecodeGithubComZeteliasGoyfinance3(l, v)
+}
+func easyjsonEc607727DecodeGithubComZeteliasGoyfinance4(in *jlexer.Lexer, out *Quote) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -44,6 +656,33 @@ func easyjsonEc607727DecodeGithubComZeteliasGoyfinance(in *jlexer.Lexer, out *Qu
 			out.PriceRangeEnd = int64(in.Int64())
 		case "Interval":
 			out.Interval = Interval(in.String())
+		case "Meta":
+			(out.Meta).UnmarshalEasyJSON(in)
+		case "ServedRange":
+			out.ServedRange = Period(in.String())
+		case "ValidRanges":
+			if in.IsNull() {
+				in.Skip()
+				out.ValidRanges = nil
+			} else {
+				in.Delim('[')
+				if out.ValidRanges == nil {
+					if !in.IsDelim(']') {
+						out.ValidRanges = make([]Period, 0, 4)
+					} else {
+						out.ValidRanges = []Period{}
+					}
+				} else {
+					out.ValidRanges = (out.ValidRanges)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v10 Period
+					v10 = Period(in.String())
+					out.ValidRanges = append(out.ValidRanges, v10)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		case "PriceHistoric":
 			if in.IsNull() {
 				in.Skip()
@@ -52,7 +691,7 @@ func easyjsonEc607727DecodeGithubComZeteliasGoyfinance(in *jlexer.Lexer, out *Qu
 				in.Delim('[')
 				if out.PriceHistoric == nil {
 					if !in.IsDelim(']') {
-						out.PriceHistoric = make([]PriceData, 0, 1)
+						out.PriceHistoric = make([]PriceData, 0, 0)
 					} else {
 						out.PriceHistoric = []PriceData{}
 					}
@@ -60,9 +699,78 @@ func easyjsonEc607727DecodeGithubComZeteliasGoyfinance(in *jlexer.Lexer, out *Qu
 					out.PriceHistoric = (out.PriceHistoric)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v1 PriceData
-					(v1).UnmarshalEasyJSON(in)
-					out.PriceHistoric = append(out.PriceHistoric, v1)
+					var v11 PriceData
+					(v11).UnmarshalEasyJSON(in)
+					out.PriceHistoric = append(out.PriceHistoric, v11)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "Dividends":
+			if in.IsNull() {
+				in.Skip()
+				out.Dividends = nil
+			} else {
+				in.Delim('[')
+				if out.Dividends == nil {
+					if !in.IsDelim(']') {
+						out.Dividends = make([]Dividend, 0, 1)
+					} else {
+						out.Dividends = []Dividend{}
+					}
+				} else {
+					out.Dividends = (out.Dividends)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v12 Dividend
+					(v12).UnmarshalEasyJSON(in)
+					out.Dividends = append(out.Dividends, v12)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "Splits":
+			if in.IsNull() {
+				in.Skip()
+				out.Splits = nil
+			} else {
+				in.Delim('[')
+				if out.Splits == nil {
+					if !in.IsDelim(']') {
+						out.Splits = make([]Split, 0, 1)
+					} else {
+						out.Splits = []Split{}
+					}
+				} else {
+					out.Splits = (out.Splits)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v13 Split
+					(v13).UnmarshalEasyJSON(in)
+					out.Splits = append(out.Splits, v13)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "CapitalGains":
+			if in.IsNull() {
+				in.Skip()
+				out.CapitalGains = nil
+			} else {
+				in.Delim('[')
+				if out.CapitalGains == nil {
+					if !in.IsDelim(']') {
+						out.CapitalGains = make([]CapitalGain, 0, 1)
+					} else {
+						out.CapitalGains = []CapitalGain{}
+					}
+				} else {
+					out.CapitalGains = (out.CapitalGains)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v14 CapitalGain
+					(v14).UnmarshalEasyJSON(in)
+					out.CapitalGains = append(out.CapitalGains, v14)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -77,7 +785,7 @@ func easyjsonEc607727DecodeGithubComZeteliasGoyfinance(in *jlexer.Lexer, out *Qu
 		in.Consumed()
 	}
 }
-func easyjsonEc607727EncodeGithubComZeteliasGoyfinance(out *jwriter.Writer, in Quote) {
+func easyjsonEc607727EncodeGithubComZeteliasGoyfinance4(out *jwriter.Writer, in Quote) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -87,32 +795,106 @@ func easyjsonEc607727EncodeGithubComZeteliasGoyfinance(out *jwriter.Writer, in Q
 		out.String(string(in.Ticker))
 	}
 	{
-		const prefix string = ",\"PriceRangeStart\":"
+		const prefix string = ",\"PriceRangeStart\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.PriceRangeStart))
+	}
+	{
+		const prefix string = ",\"PriceRangeEnd\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.PriceRangeEnd))
+	}
+	{
+		const prefix string = ",\"Interval\":"
+		out.RawString(prefix)
+		out.String(string(in.Interval))
+	}
+	{
+		const prefix string = ",\"Meta\":"
+		out.RawString(prefix)
+		(in.Meta).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"ServedRange\":"
+		out.RawString(prefix)
+		out.String(string(in.ServedRange))
+	}
+	{
+		const prefix string = ",\"ValidRanges\":"
+		out.RawString(prefix)
+		if in.ValidRanges == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v15, v16 := range in.ValidRanges {
+				if v15 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v16))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"PriceHistoric\":"
 		out.RawString(prefix)
-		out.Int64(int64(in.PriceRangeStart))
+		if in.PriceHistoric == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v17, v18 := range in.PriceHistoric {
+				if v17 > 0 {
+					out.RawByte(',')
+				}
+				(v18).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
 	}
 	{
-		const prefix string = ",\"PriceRangeEnd\":"
+		const prefix string = ",\"Dividends\":"
 		out.RawString(prefix)
-		out.Int64(int64(in.PriceRangeEnd))
+		if in.Dividends == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v19, v20 := range in.Dividends {
+				if v19 > 0 {
+					out.RawByte(',')
+				}
+				(v20).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
 	}
 	{
-		const prefix string = ",\"Interval\":"
+		const prefix string = ",\"Splits\":"
 		out.RawString(prefix)
-		out.String(string(in.Interval))
+		if in.Splits == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v21, v22 := range in.Splits {
+				if v21 > 0 {
+					out.RawByte(',')
+				}
+				(v22).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
 	}
 	{
-		const prefix string = ",\"PriceHistoric\":"
+		const prefix string = ",\"CapitalGains\":"
 		out.RawString(prefix)
-		if in.PriceHistoric == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		if in.CapitalGains == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v2, v3 := range in.PriceHistoric {
-				if v2 > 0 {
+			for v23, v24 := range in.CapitalGains {
+				if v23 > 0 {
 					out.RawByte(',')
 				}
-				(v3).MarshalEasyJSON(out)
+				(v24).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -123,27 +905,27 @@ func easyjsonEc607727EncodeGithubComZeteliasGoyfinance(out *jwriter.Writer, in Q
 // MarshalJSON supports json.Marshaler interface
 func (v Quote) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonEc607727EncodeGithubComZeteliasGoyfinance(&w, v)
+	easyjsonEc607727EncodeGithubComZeteliasGoyfinance4(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Quote) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonEc607727EncodeGithubComZeteliasGoyfinance(w, v)
+	easyjsonEc607727EncodeGithubComZeteliasGoyfinance4(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *Quote) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonEc607727DecodeGithubComZeteliasGoyfinance(&r, v)
+	easyjsonEc607727DecodeGithubComZeteliasGoyfinance4(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Quote) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonEc607727DecodeGithubComZeteliasGoyfinance(l, v)
+	easyjsonEc607727DecodeGithubComZeteliasGoyfinance4(l, v)
 }
-func easyjsonEc607727DecodeGithubComZeteliasGoyfinance1(in *jlexer.Lexer, out *PriceData) {
+func easyjsonEc607727DecodeGithubComZeteliasGoyfinance5(in *jlexer.Lexer, out *PriceData) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -162,6 +944,12 @@ func easyjsonEc607727DecodeGithubComZeteliasGoyfinance1(in *jlexer.Lexer, out *P
 			continue
 		}
 		switch key {
+		case "Time":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Time).UnmarshalJSON(data))
+			}
+		case "Timestamp":
+			out.Timestamp = int64(in.Int64())
 		case "OpenPrice":
 			out.OpenPrice = float64(in.Float64())
 		case "LowPrice":
@@ -170,8 +958,14 @@ func easyjsonEc607727DecodeGithubComZeteliasGoyfinance1(in *jlexer.Lexer, out *P
 			out.HighPrice = float64(in.Float64())
 		case "ClosePrice":
 			out.ClosePrice = float64(in.Float64())
+		case "AdjClosePrice":
+			out.AdjClosePrice = float64(in.Float64())
 		case "Volume":
 			out.Volume = int(in.Int())
+		case "Missing":
+			out.Missing = bool(in.Bool())
+		case "Session":
+			out.Session = Session(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -182,13 +976,23 @@ func easyjsonEc607727DecodeGithubComZeteliasGoyfinance1(in *jlexer.Lexer, out *P
 		in.Consumed()
 	}
 }
-func easyjsonEc607727EncodeGithubComZeteliasGoyfinance1(out *jwriter.Writer, in PriceData) {
+func easyjsonEc607727EncodeGithubComZeteliasGoyfinance5(out *jwriter.Writer, in PriceData) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"OpenPrice\":"
+		const prefix string = ",\"Time\":"
 		out.RawString(prefix[1:])
+		out.Raw((in.Time).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"Timestamp\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Timestamp))
+	}
+	{
+		const prefix string = ",\"OpenPrice\":"
+		out.RawString(prefix)
 		out.Float64(float64(in.OpenPrice))
 	}
 	{
@@ -206,38 +1010,140 @@ func easyjsonEc607727EncodeGithubComZeteliasGoyfinance1(out *jwriter.Writer, in
 		out.RawString(prefix)
 		out.Float64(float64(in.ClosePrice))
 	}
+	{
+		const prefix string = ",\"AdjClosePrice\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.AdjClosePrice))
+	}
 	{
 		const prefix string = ",\"Volume\":"
 		out.RawString(prefix)
 		out.Int(int(in.Volume))
 	}
+	{
+		const prefix string = ",\"Missing\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Missing))
+	}
+	{
+		const prefix string = ",\"Session\":"
+		out.RawString(prefix)
+		out.String(string(in.Session))
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
 func (v PriceData) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonEc607727EncodeGithubComZeteliasGoyfinance1(&w, v)
+	easyjsonEc607727EncodeGithubComZeteliasGoyfinance5(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v PriceData) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonEc607727EncodeGithubComZeteliasGoyfinance1(w, v)
+	easyjsonEc607727EncodeGithubComZeteliasGoyfinance5(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *PriceData) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonEc607727DecodeGithubComZeteliasGoyfinance1(&r, v)
+	easyjsonEc607727DecodeGithubComZeteliasGoyfinance5(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *PriceData) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonEc607727DecodeGithubComZeteliasGoyfinance1(l, v)
+	easyjsonEc607727DecodeGithubComZeteliasGoyfinance5(l, v)
+}
+func easyjsonEc607727DecodeGithubComZeteliasGoyfinance6(in *jlexer.Lexer, out *JSONTradingPeriod) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "timezone":
+			out.Timezone = string(in.String())
+		case "start":
+			out.Start = int(in.Int())
+		case "end":
+			out.End = int(in.Int())
+		case "gmtoffset":
+			out.Gmtoffset = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonEc607727EncodeGithubComZeteliasGoyfinance6(out *jwriter.Writer, in JSONTradingPeriod) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"timezone\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Timezone))
+	}
+	{
+		const prefix string = ",\"start\":"
+		out.RawString(prefix)
+		out.Int(int(in.Start))
+	}
+	{
+		const prefix string = ",\"end\":"
+		out.RawString(prefix)
+		out.Int(int(in.End))
+	}
+	{
+		const prefix string = ",\"gmtoffset\":"
+		out.RawString(prefix)
+		out.Int(int(in.Gmtoffset))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v JSONTradingPeriod) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonEc607727EncodeGithubComZeteliasGoyfinance6(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v JSONTradingPeriod) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonEc607727EncodeGithubComZeteliasGoyfinance6(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *JSONTradingPeriod) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonEc607727DecodeGithubComZeteliasGoyfinance6(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *JSONTradingPeriod) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonEc607727DecodeGithubComZeteliasGoyfinance6(l, v)
 }
-func easyjsonEc607727DecodeGithubComZeteliasGoyfinance2(in *jlexer.Lexer, out *JSONQuote) {
+func easyjsonEc607727DecodeGithubComZeteliasGoyfinance7(in *jlexer.Lexer, out *JSONQuote) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -257,7 +1163,7 @@ func easyjsonEc607727DecodeGithubComZeteliasGoyfinance2(in *jlexer.Lexer, out *J
 		}
 		switch key {
 		case "chart":
-			easyjsonEc607727Decode(in, &out.Chart)
+			easyjsonEc607727Decode1(in, &out.Chart)
 		default:
 			in.SkipRecursive()
 		}
@@ -268,14 +1174,14 @@ func easyjsonEc607727DecodeGithubComZeteliasGoyfinance2(in *jlexer.Lexer, out *J
 		in.Consumed()
 	}
 }
-func easyjsonEc607727EncodeGithubComZeteliasGoyfinance2(out *jwriter.Writer, in JSONQuote) {
+func easyjsonEc607727EncodeGithubComZeteliasGoyfinance7(out *jwriter.Writer, in JSONQuote) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
 		const prefix string = ",\"chart\":"
 		out.RawString(prefix[1:])
-		easyjsonEc607727Encode(out, in.Chart)
+		easyjsonEc607727Encode1(out, in.Chart)
 	}
 	out.RawByte('}')
 }
@@ -283,27 +1189,27 @@ func easyjsonEc607727EncodeGithubComZeteliasGoyfinance2(out *jwriter.Writer, in
 // MarshalJSON supports json.Marshaler interface
 func (v JSONQuote) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonEc607727EncodeGithubComZeteliasGoyfinance2(&w, v)
+	easyjsonEc607727EncodeGithubComZeteliasGoyfinance7(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v JSONQuote) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonEc607727EncodeGithubComZeteliasGoyfinance2(w, v)
+	easyjsonEc607727EncodeGithubComZeteliasGoyfinance7(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *JSONQuote) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonEc607727DecodeGithubComZeteliasGoyfinance2(&r, v)
+	easyjsonEc607727DecodeGithubComZeteliasGoyfinance7(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *JSONQuote) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonEc607727DecodeGithubComZeteliasGoyfinance2(l, v)
+	easyjsonEc607727DecodeGithubComZeteliasGoyfinance7(l, v)
 }
-func easyjsonEc607727Decode(in *jlexer.Lexer, out *struct {
+func easyjsonEc607727Decode1(in *jlexer.Lexer, out *struct {
 	Result []struct {
 		Meta struct {
 			Currency             string  `json:"currency"`
@@ -321,47 +1227,46 @@ func easyjsonEc607727Decode(in *jlexer.Lexer, out *struct {
 			Scale                int     `json:"scale"`
 			PriceHint            int     `json:"priceHint"`
 			CurrentTradingPeriod struct {
-				Pre struct {
-					Timezone  string `json:"timezone"`
-					Start     int    `json:"start"`
-					End       int    `json:"end"`
-					Gmtoffset int    `json:"gmtoffset"`
-				} `json:"pre"`
-				Regular struct {
-					Timezone  string `json:"timezone"`
-					Start     int    `json:"start"`
-					End       int    `json:"end"`
-					Gmtoffset int    `json:"gmtoffset"`
-				} `json:"regular"`
-				Post struct {
-					Timezone  string `json:"timezone"`
-					Start     int    `json:"start"`
-					End       int    `json:"end"`
-					Gmtoffset int    `json:"gmtoffset"`
-				} `json:"post"`
+				Pre     JSONTradingPeriod `json:"pre"`
+				Regular JSONTradingPeriod `json:"regular"`
+				Post    JSONTradingPeriod `json:"post"`
 			} `json:"currentTradingPeriod"`
-			TradingPeriods [][]struct {
-				Timezone  string `json:"timezone"`
-				Start     int    `json:"start"`
-				End       int    `json:"end"`
-				Gmtoffset int    `json:"gmtoffset"`
-			} `json:"tradingPeriods"`
-			DataGranularity string   `json:"dataGranularity"`
-			Range           string   `json:"range"`
-			ValidRanges     []string `json:"validRanges"`
+			TradingPeriods  JSONTradingPeriods `json:"tradingPeriods"`
+			DataGranularity string             `json:"dataGranularity"`
+			Range           string             `json:"range"`
+			ValidRanges     []string           `json:"validRanges"`
 		} `json:"meta"`
-		Timestamp  []int `json:"timestamp"`
+		Timestamp []int `json:"timestamp"`
+		Events    struct {
+			Dividends map[string]struct {
+				Amount float64 `json:"amount"`
+				Date   int64   `json:"date"`
+			} `json:"dividends"`
+			Splits map[string]struct {
+				Date        int64   `json:"date"`
+				Numerator   float64 `json:"numerator"`
+				Denominator float64 `json:"denominator"`
+				SplitRatio  string  `json:"splitRatio"`
+			} `json:"splits"`
+			CapitalGains map[string]struct {
+				Amount float64 `json:"amount"`
+				Date   int64   `json:"date"`
+			} `json:"capitalGains"`
+		} `json:"events"`
 		Indicators struct {
 			Quote []struct {
-				Open   []float64 `json:"open"`
-				Low    []float64 `json:"low"`
-				Volume []int     `json:"volume"`
-				High   []float64 `json:"high"`
-				Close  []float64 `json:"close"`
+				Open   []opt.Float64 `json:"open"`
+				Low    []opt.Float64 `json:"low"`
+				Volume []opt.Int     `json:"volume"`
+				High   []opt.Float64 `json:"high"`
+				Close  []opt.Float64 `json:"close"`
 			} `json:"quote"`
+			Adjclose []struct {
+				Adjclose []opt.Float64 `json:"adjclose"`
+			} `json:"adjclose"`
 		} `json:"indicators"`
 	} `json:"result"`
-	Error interface{} `json:"error"`
+	Error *JSONError `json:"error"`
 }) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
@@ -406,44 +1311,43 @@ func easyjsonEc607727Decode(in *jlexer.Lexer, out *struct {
 								Scale                int     `json:"scale"`
 								PriceHint            int     `json:"priceHint"`
 								CurrentTradingPeriod struct {
-									Pre struct {
-										Timezone  string `json:"timezone"`
-										Start     int    `json:"start"`
-										End       int    `json:"end"`
-										Gmtoffset int    `json:"gmtoffset"`
-									} `json:"pre"`
-									Regular struct {
-										Timezone  string `json:"timezone"`
-										Start     int    `json:"start"`
-										End       int    `json:"end"`
-										Gmtoffset int    `json:"gmtoffset"`
-									} `json:"regular"`
-									Post struct {
-										Timezone  string `json:"timezone"`
-										Start     int    `json:"start"`
-										End       int    `json:"end"`
-										Gmtoffset int    `json:"gmtoffset"`
-									} `json:"post"`
+									Pre     JSONTradingPeriod `json:"pre"`
+									Regular JSONTradingPeriod `json:"regular"`
+									Post    JSONTradingPeriod `json:"post"`
 								} `json:"currentTradingPeriod"`
-								TradingPeriods [][]struct {
-									Timezone  string `json:"timezone"`
-									Start     int    `json:"start"`
-									End       int    `json:"end"`
-									Gmtoffset int    `json:"gmtoffset"`
-								} `json:"tradingPeriods"`
-								DataGranularity string   `json:"dataGranularity"`
-								Range           string   `json:"range"`
-								ValidRanges     []string `json:"validRanges"`
+								TradingPeriods  JSONTradingPeriods `json:"tradingPeriods"`
+								DataGranularity string             `json:"dataGranularity"`
+								Range           string             `json:"range"`
+								ValidRanges     []string           `json:"validRanges"`
 							} `json:"meta"`
-							Timestamp  []int `json:"timestamp"`
+							Timestamp []int `json:"timestamp"`
+							Events    struct {
+								Dividends map[string]struct {
+									Amount float64 `json:"amount"`
+									Date   int64   `json:"date"`
+								} `json:"dividends"`
+								Splits map[string]struct {
+									Date        int64   `json:"date"`
+									Numerator   float64 `json:"numerator"`
+									Denominator float64 `json:"denominator"`
+									SplitRatio  string  `json:"splitRatio"`
+								} `json:"splits"`
+								CapitalGains map[string]struct {
+									Amount float64 `json:"amount"`
+									Date   int64   `json:"date"`
+								} `json:"capitalGains"`
+							} `json:"events"`
 							Indicators struct {
 								Quote []struct {
-									Open   []float64 `json:"open"`
-									Low    []float64 `json:"low"`
-									Volume []int     `json:"volume"`
-									High   []float64 `json:"high"`
-									Close  []float64 `json:"close"`
+									Open   []opt.Float64 `json:"open"`
+									Low    []opt.Float64 `json:"low"`
+									Volume []opt.Int     `json:"volume"`
+									High   []opt.Float64 `json:"high"`
+									Close  []opt.Float64 `json:"close"`
 								} `json:"quote"`
+								Adjclose []struct {
+									Adjclose []opt.Float64 `json:"adjclose"`
+								} `json:"adjclose"`
 							} `json:"indicators"`
 						}, 0, 0)
 					} else {
@@ -464,44 +1368,43 @@ func easyjsonEc607727Decode(in *jlexer.Lexer, out *struct {
 								Scale                int     `json:"scale"`
 								PriceHint            int     `json:"priceHint"`
 								CurrentTradingPeriod struct {
-									Pre struct {
-										Timezone  string `json:"timezone"`
-										Start     int    `json:"start"`
-										End       int    `json:"end"`
-										Gmtoffset int    `json:"gmtoffset"`
-									} `json:"pre"`
-									Regular struct {
-										Timezone  string `json:"timezone"`
-										Start     int    `json:"start"`
-										End       int    `json:"end"`
-										Gmtoffset int    `json:"gmtoffset"`
-									} `json:"regular"`
-									Post struct {
-										Timezone  string `json:"timezone"`
-										Start     int    `json:"start"`
-										End       int    `json:"end"`
-										Gmtoffset int    `json:"gmtoffset"`
-									} `json:"post"`
+									Pre     JSONTradingPeriod `json:"pre"`
+									Regular JSONTradingPeriod `json:"regular"`
+									Post    JSONTradingPeriod `json:"post"`
 								} `json:"currentTradingPeriod"`
-								TradingPeriods [][]struct {
-									Timezone  string `json:"timezone"`
-									Start     int    `json:"start"`
-									End       int    `json:"end"`
-									Gmtoffset int    `json:"gmtoffset"`
-								} `json:"tradingPeriods"`
-								DataGranularity string   `json:"dataGranularity"`
-								Range           string   `json:"range"`
-								ValidRanges     []string `json:"validRanges"`
+								TradingPeriods  JSONTradingPeriods `json:"tradingPeriods"`
+								DataGranularity string             `json:"dataGranularity"`
+								Range           string             `json:"range"`
+								ValidRanges     []string           `json:"validRanges"`
 							} `json:"meta"`
-							Timestamp  []int `json:"timestamp"`
+							Timestamp []int `json:"timestamp"`
+							Events    struct {
+								Dividends map[string]struct {
+									Amount float64 `json:"amount"`
+									Date   int64   `json:"date"`
+								} `json:"dividends"`
+								Splits map[string]struct {
+									Date        int64   `json:"date"`
+									Numerator   float64 `json:"numerator"`
+									Denominator float64 `json:"denominator"`
+									SplitRatio  string  `json:"splitRatio"`
+								} `json:"splits"`
+								CapitalGains map[string]struct {
+									Amount float64 `json:"amount"`
+									Date   int64   `json:"date"`
+								} `json:"capitalGains"`
+							} `json:"events"`
 							Indicators struct {
 								Quote []struct {
-									Open   []float64 `json:"open"`
-									Low    []float64 `json:"low"`
-									Volume []int     `json:"volume"`
-									High   []float64 `json:"high"`
-									Close  []float64 `json:"close"`
+									Open   []opt.Float64 `json:"open"`
+									Low    []opt.Float64 `json:"low"`
+									Volume []opt.Int     `json:"volume"`
+									High   []opt.Float64 `json:"high"`
+									Close  []opt.Float64 `json:"close"`
 								} `json:"quote"`
+								Adjclose []struct {
+									Adjclose []opt.Float64 `json:"adjclose"`
+								} `json:"adjclose"`
 							} `json:"indicators"`
 						}{}
 					}
@@ -509,7 +1412,7 @@ func easyjsonEc607727Decode(in *jlexer.Lexer, out *struct {
 					out.Result = (out.Result)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v4 struct {
+					var v25 struct {
 						Meta struct {
 							Currency             string  `json:"currency"`
 							Symbol               string  `json:"symbol"`
@@ -526,59 +1429,60 @@ func easyjsonEc607727Decode(in *jlexer.Lexer, out *struct {
 							Scale                int     `json:"scale"`
 							PriceHint            int     `json:"priceHint"`
 							CurrentTradingPeriod struct {
-								Pre struct {
-									Timezone  string `json:"timezone"`
-									Start     int    `json:"start"`
-									End       int    `json:"end"`
-									Gmtoffset int    `json:"gmtoffset"`
-								} `json:"pre"`
-								Regular struct {
-									Timezone  string `json:"timezone"`
-									Start     int    `json:"start"`
-									End       int    `json:"end"`
-									Gmtoffset int    `json:"gmtoffset"`
-								} `json:"regular"`
-								Post struct {
-									Timezone  string `json:"timezone"`
-									Start     int    `json:"start"`
-									End       int    `json:"end"`
-									Gmtoffset int    `json:"gmtoffset"`
-								} `json:"post"`
+								Pre     JSONTradingPeriod `json:"pre"`
+								Regular JSONTradingPeriod `json:"regular"`
+								Post    JSONTradingPeriod `json:"post"`
 							} `json:"currentTradingPeriod"`
-							TradingPeriods [][]struct {
-								Timezone  string `json:"timezone"`
-								Start     int    `json:"start"`
-								End       int    `json:"end"`
-								Gmtoffset int    `json:"gmtoffset"`
-							} `json:"tradingPeriods"`
-							DataGranularity string   `json:"dataGranularity"`
-							Range           string   `json:"range"`
-							ValidRanges     []string `json:"validRanges"`
+							TradingPeriods  JSONTradingPeriods `json:"tradingPeriods"`
+							DataGranularity string             `json:"dataGranularity"`
+							Range           string             `json:"range"`
+							ValidRanges     []string           `json:"validRanges"`
 						} `json:"meta"`
-						Timestamp  []int `json:"timestamp"`
+						Timestamp []int `json:"timestamp"`
+						Events    struct {
+							Dividends map[string]struct {
+								Amount float64 `json:"amount"`
+								Date   int64   `json:"date"`
+							} `json:"dividends"`
+							Splits map[string]struct {
+								Date        int64   `json:"date"`
+								Numerator   float64 `json:"numerator"`
+								Denominator float64 `json:"denominator"`
+								SplitRatio  string  `json:"splitRatio"`
+							} `json:"splits"`
+							CapitalGains map[string]struct {
+								Amount float64 `json:"amount"`
+								Date   int64   `json:"date"`
+							} `json:"capitalGains"`
+						} `json:"events"`
 						Indicators struct {
 							Quote []struct {
-								Open   []float64 `json:"open"`
-								Low    []float64 `json:"low"`
-								Volume []int     `json:"volume"`
-								High   []float64 `json:"high"`
-								Close  []float64 `json:"close"`
+								Open   []opt.Float64 `json:"open"`
+								Low    []opt.Float64 `json:"low"`
+								Volume []opt.Int     `json:"volume"`
+								High   []opt.Float64 `json:"high"`
+								Close  []opt.Float64 `json:"close"`
 							} `json:"quote"`
+							Adjclose []struct {
+								Adjclose []opt.Float64 `json:"adjclose"`
+							} `json:"adjclose"`
 						} `json:"indicators"`
 					}
-					easyjsonEc607727Decode1(in, &v4)
-					out.Result = append(out.Result, v4)
+					easyjsonEc607727Decode2(in, &v25)
+					out.Result = append(out.Result, v25)
 					in.WantComma()
 				}
 				in.Delim(']')
 			}
 		case "error":
-			if m, ok := out.Error.(easyjson.Unmarshaler); ok {
-				m.UnmarshalEasyJSON(in)
-			} else if m, ok := out.Error.(json.Unmarshaler); ok {
-				_ = m.UnmarshalJSON(in.Raw())
+			if in.IsNull() {
+				in.Skip()
+				out.Error = nil
 			} else {
-				out.Error = in.Interface()
+				if out.Error == nil {
+					out.Error = new(JSONError)
+				}
+				(*out.Error).UnmarshalEasyJSON(in)
 			}
 		default:
 			in.SkipRecursive()
@@ -590,7 +1494,7 @@ func easyjsonEc607727Decode(in *jlexer.Lexer, out *struct {
 		in.Consumed()
 	}
 }
-func easyjsonEc607727Encode(out *jwriter.Writer, in struct {
+func easyjsonEc607727Encode1(out *jwriter.Writer, in struct {
 	Result []struct {
 		Meta struct {
 			Currency             string  `json:"currency"`
@@ -608,47 +1512,46 @@ func easyjsonEc607727Encode(out *jwriter.Writer, in struct {
 			Scale                int     `json:"scale"`
 			PriceHint            int     `json:"priceHint"`
 			CurrentTradingPeriod struct {
-				Pre struct {
-					Timezone  string `json:"timezone"`
-					Start     int    `json:"start"`
-					End       int    `json:"end"`
-					Gmtoffset int    `json:"gmtoffset"`
-				} `json:"pre"`
-				Regular struct {
-					Timezone  string `json:"timezone"`
-					Start     int    `json:"start"`
-					End       int    `json:"end"`
-					Gmtoffset int    `json:"gmtoffset"`
-				} `json:"regular"`
-				Post struct {
-					Timezone  string `json:"timezone"`
-					Start     int    `json:"start"`
-					End       int    `json:"end"`
-					Gmtoffset int    `json:"gmtoffset"`
-				} `json:"post"`
+				Pre     JSONTradingPeriod `json:"pre"`
+				Regular JSONTradingPeriod `json:"regular"`
+				Post    JSONTradingPeriod `json:"post"`
 			} `json:"currentTradingPeriod"`
-			TradingPeriods [][]struct {
-				Timezone  string `json:"timezone"`
-				Start     int    `json:"start"`
-				End       int    `json:"end"`
-				Gmtoffset int    `json:"gmtoffset"`
-			} `json:"tradingPeriods"`
-			DataGranularity string   `json:"dataGranularity"`
-			Range           string   `json:"range"`
-			ValidRanges     []string `json:"validRanges"`
+			TradingPeriods  JSONTradingPeriods `json:"tradingPeriods"`
+			DataGranularity string             `json:"dataGranularity"`
+			Range           string             `json:"range"`
+			ValidRanges     []string           `json:"validRanges"`
 		} `json:"meta"`
-		Timestamp  []int `json:"timestamp"`
+		Timestamp []int `json:"timestamp"`
+		Events    struct {
+			Dividends map[string]struct {
+				Amount float64 `json:"amount"`
+				Date   int64   `json:"date"`
+			} `json:"dividends"`
+			Splits map[string]struct {
+				Date        int64   `json:"date"`
+				Numerator   float64 `json:"numerator"`
+				Denominator float64 `json:"denominator"`
+				SplitRatio  string  `json:"splitRatio"`
+			} `json:"splits"`
+			CapitalGains map[string]struct {
+				Amount float64 `json:"amount"`
+				Date   int64   `json:"date"`
+			} `json:"capitalGains"`
+		} `json:"events"`
 		Indicators struct {
 			Quote []struct {
-				Open   []float64 `json:"open"`
-				Low    []float64 `json:"low"`
-				Volume []int     `json:"volume"`
-				High   []float64 `json:"high"`
-				Close  []float64 `json:"close"`
+				Open   []opt.Float64 `json:"open"`
+				Low    []opt.Float64 `json:"low"`
+				Volume []opt.Int     `json:"volume"`
+				High   []opt.Float64 `json:"high"`
+				Close  []opt.Float64 `json:"close"`
 			} `json:"quote"`
+			Adjclose []struct {
+				Adjclose []opt.Float64 `json:"adjclose"`
+			} `json:"adjclose"`
 		} `json:"indicators"`
 	} `json:"result"`
-	Error interface{} `json:"error"`
+	Error *JSONError `json:"error"`
 }) {
 	out.RawByte('{')
 	first := true
@@ -660,11 +1563,11 @@ func easyjsonEc607727Encode(out *jwriter.Writer, in struct {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v5, v6 := range in.Result {
-				if v5 > 0 {
+			for v26, v27 := range in.Result {
+				if v26 > 0 {
 					out.RawByte(',')
 				}
-				easyjsonEc607727Encode1(out, v6)
+				easyjsonEc607727Encode2(out, v27)
 			}
 			out.RawByte(']')
 		}
@@ -672,17 +1575,15 @@ func easyjsonEc607727Encode(out *jwriter.Writer, in struct {
 	{
 		const prefix string = ",\"error\":"
 		out.RawString(prefix)
-		if m, ok := in.Error.(easyjson.Marshaler); ok {
-			m.MarshalEasyJSON(out)
-		} else if m, ok := in.Error.(json.Marshaler); ok {
-			out.Raw(m.MarshalJSON())
+		if in.Error == nil {
+			out.RawString("null")
 		} else {
-			out.Raw(json.Marshal(in.Error))
+			(*in.Error).MarshalEasyJSON(out)
 		}
 	}
 	out.RawByte('}')
 }
-func easyjsonEc607727Decode1(in *jlexer.Lexer, out *struct {
+func easyjsonEc607727Decode2(in *jlexer.Lexer, out *struct {
 	Meta struct {
 		Currency             string  `json:"currency"`
 		Symbol               string  `json:"symbol"`
@@ -699,44 +1600,43 @@ func easyjsonEc607727Decode1(in *jlexer.Lexer, out *struct {
 		Scale                int     `json:"scale"`
 		PriceHint            int     `json:"priceHint"`
 		CurrentTradingPeriod struct {
-			Pre struct {
-				Timezone  string `json:"timezone"`
-				Start     int    `json:"start"`
-				End       int    `json:"end"`
-				Gmtoffset int    `json:"gmtoffset"`
-			} `json:"pre"`
-			Regular struct {
-				Timezone  string `json:"timezone"`
-				Start     int    `json:"start"`
-				End       int    `json:"end"`
-				Gmtoffset int    `json:"gmtoffset"`
-			} `json:"regular"`
-			Post struct {
-				Timezone  string `json:"timezone"`
-				Start     int    `json:"start"`
-				End       int    `json:"end"`
-				Gmtoffset int    `json:"gmtoffset"`
-			} `json:"post"`
+			Pre     JSONTradingPeriod `json:"pre"`
+			Regular JSONTradingPeriod `json:"regular"`
+			Post    JSONTradingPeriod `json:"post"`
 		} `json:"currentTradingPeriod"`
-		TradingPeriods [][]struct {
-			Timezone  string `json:"timezone"`
-			Start     int    `json:"start"`
-			End       int    `json:"end"`
-			Gmtoffset int    `json:"gmtoffset"`
-		} `json:"tradingPeriods"`
-		DataGranularity string   `json:"dataGranularity"`
-		Range           string   `json:"range"`
-		ValidRanges     []string `json:"validRanges"`
+		TradingPeriods  JSONTradingPeriods `json:"tradingPeriods"`
+		DataGranularity string             `json:"dataGranularity"`
+		Range           string             `json:"range"`
+		ValidRanges     []string           `json:"validRanges"`
 	} `json:"meta"`
-	Timestamp  []int `json:"timestamp"`
+	Timestamp []int `json:"timestamp"`
+	Events    struct {
+		Dividends map[string]struct {
+			Amount float64 `json:"amount"`
+			Date   int64   `json:"date"`
+		} `json:"dividends"`
+		Splits map[string]struct {
+			Date        int64   `json:"date"`
+			Numerator   float64 `json:"numerator"`
+			Denominator float64 `json:"denominator"`
+			SplitRatio  string  `json:"splitRatio"`
+		} `json:"splits"`
+		CapitalGains map[string]struct {
+			Amount float64 `json:"amount"`
+			Date   int64   `json:"date"`
+		} `json:"capitalGains"`
+	} `json:"events"`
 	Indicators struct {
 		Quote []struct {
-			Open   []float64 `json:"open"`
-			Low    []float64 `json:"low"`
-			Volume []int     `json:"volume"`
-			High   []float64 `json:"high"`
-			Close  []float64 `json:"close"`
+			Open   []opt.Float64 `json:"open"`
+			Low    []opt.Float64 `json:"low"`
+			Volume []opt.Int     `json:"volume"`
+			High   []opt.Float64 `json:"high"`
+			Close  []opt.Float64 `json:"close"`
 		} `json:"quote"`
+		Adjclose []struct {
+			Adjclose []opt.Float64 `json:"adjclose"`
+		} `json:"adjclose"`
 	} `json:"indicators"`
 }) {
 	isTopLevel := in.IsStart()
@@ -758,7 +1658,7 @@ func easyjsonEc607727Decode1(in *jlexer.Lexer, out *struct {
 		}
 		switch key {
 		case "meta":
-			easyjsonEc607727Decode2(in, &out.Meta)
+			easyjsonEc607727Decode3(in, &out.Meta)
 		case "timestamp":
 			if in.IsNull() {
 				in.Skip()
@@ -775,15 +1675,17 @@ func easyjsonEc607727Decode1(in *jlexer.Lexer, out *struct {
 					out.Timestamp = (out.Timestamp)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v7 int
-					v7 = int(in.Int())
-					out.Timestamp = append(out.Timestamp, v7)
+					var v28 int
+					v28 = int(in.Int())
+					out.Timestamp = append(out.Timestamp, v28)
 					in.WantComma()
 				}
 				in.Delim(']')
 			}
+		case "events":
+			easyjsonEc607727Decode4(in, &out.Events)
 		case "indicators":
-			easyjsonEc607727Decode3(in, &out.Indicators)
+			easyjsonEc607727Decode5(in, &out.Indicators)
 		default:
 			in.SkipRecursive()
 		}
@@ -794,7 +1696,7 @@ func easyjsonEc607727Decode1(in *jlexer.Lexer, out *struct {
 		in.Consumed()
 	}
 }
-func easyjsonEc607727Encode1(out *jwriter.Writer, in struct {
+func easyjsonEc607727Encode2(out *jwriter.Writer, in struct {
 	Meta struct {
 		Currency             string  `json:"currency"`
 		Symbol               string  `json:"symbol"`
@@ -811,44 +1713,43 @@ func easyjsonEc607727Encode1(out *jwriter.Writer, in struct {
 		Scale                int     `json:"scale"`
 		PriceHint            int     `json:"priceHint"`
 		CurrentTradingPeriod struct {
-			Pre struct {
-				Timezone  string `json:"timezone"`
-				Start     int    `json:"start"`
-				End       int    `json:"end"`
-				Gmtoffset int    `json:"gmtoffset"`
-			} `json:"pre"`
-			Regular struct {
-				Timezone  string `json:"timezone"`
-				Start     int    `json:"start"`
-				End       int    `json:"end"`
-				Gmtoffset int    `json:"gmtoffset"`
-			} `json:"regular"`
-			Post struct {
-				Timezone  string `json:"timezone"`
-				Start     int    `json:"start"`
-				End       int    `json:"end"`
-				Gmtoffset int    `json:"gmtoffset"`
-			} `json:"post"`
+			Pre     JSONTradingPeriod `json:"pre"`
+			Regular JSONTradingPeriod `json:"regular"`
+			Post    JSONTradingPeriod `json:"post"`
 		} `json:"currentTradingPeriod"`
-		TradingPeriods [][]struct {
-			Timezone  string `json:"timezone"`
-			Start     int    `json:"start"`
-			End       int    `json:"end"`
-			Gmtoffset int    `json:"gmtoffset"`
-		} `json:"tradingPeriods"`
-		DataGranularity string   `json:"dataGranularity"`
-		Range           string   `json:"range"`
-		ValidRanges     []string `json:"validRanges"`
+		TradingPeriods  JSONTradingPeriods `json:"tradingPeriods"`
+		DataGranularity string             `json:"dataGranularity"`
+		Range           string             `json:"range"`
+		ValidRanges     []string           `json:"validRanges"`
 	} `json:"meta"`
-	Timestamp  []int `json:"timestamp"`
+	Timestamp []int `json:"timestamp"`
+	Events    struct {
+		Dividends map[string]struct {
+			Amount float64 `json:"amount"`
+			Date   int64   `json:"date"`
+		} `json:"dividends"`
+		Splits map[string]struct {
+			Date        int64   `json:"date"`
+			Numerator   float64 `json:"numerator"`
+			Denominator float64 `json:"denominator"`
+			SplitRatio  string  `json:"splitRatio"`
+		} `json:"splits"`
+		CapitalGains map[string]struct {
+			Amount float64 `json:"amount"`
+			Date   int64   `json:"date"`
+		} `json:"capitalGains"`
+	} `json:"events"`
 	Indicators struct {
 		Quote []struct {
-			Open   []float64 `json:"open"`
-			Low    []float64 `json:"low"`
-			Volume []int     `json:"volume"`
-			High   []float64 `json:"high"`
-			Close  []float64 `json:"close"`
+			Open   []opt.Float64 `json:"open"`
+			Low    []opt.Float64 `json:"low"`
+			Volume []opt.Int     `json:"volume"`
+			High   []opt.Float64 `json:"high"`
+			Close  []opt.Float64 `json:"close"`
 		} `json:"quote"`
+		Adjclose []struct {
+			Adjclose []opt.Float64 `json:"adjclose"`
+		} `json:"adjclose"`
 	} `json:"indicators"`
 }) {
 	out.RawByte('{')
@@ -857,7 +1758,7 @@ func easyjsonEc607727Encode1(out *jwriter.Writer, in struct {
 	{
 		const prefix string = ",\"meta\":"
 		out.RawString(prefix[1:])
-		easyjsonEc607727Encode2(out, in.Meta)
+		easyjsonEc607727Encode3(out, in.Meta)
 	}
 	{
 		const prefix string = ",\"timestamp\":"
@@ -866,30 +1767,188 @@ func easyjsonEc607727Encode1(out *jwriter.Writer, in struct {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v8, v9 := range in.Timestamp {
-				if v8 > 0 {
+			for v29, v30 := range in.Timestamp {
+				if v29 > 0 {
+					out.RawByte(',')
+				}
+				out.Int(int(v30))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"events\":"
+		out.RawString(prefix)
+		easyjsonEc607727Encode4(out, in.Events)
+	}
+	{
+		const prefix string = ",\"indicators\":"
+		out.RawString(prefix)
+		easyjsonEc607727Encode5(out, in.Indicators)
+	}
+	out.RawByte('}')
+}
+func easyjsonEc607727Decode5(in *jlexer.Lexer, out *struct {
+	Quote []struct {
+		Open   []opt.Float64 `json:"open"`
+		Low    []opt.Float64 `json:"low"`
+		Volume []opt.Int     `json:"volume"`
+		High   []opt.Float64 `json:"high"`
+		Close  []opt.Float64 `json:"close"`
+	} `json:"quote"`
+	Adjclose []struct {
+		Adjclose []opt.Float64 `json:"adjclose"`
+	} `json:"adjclose"`
+}) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "quote":
+			if in.IsNull() {
+				in.Skip()
+				out.Quote = nil
+			} else {
+				in.Delim('[')
+				if out.Quote == nil {
+					if !in.IsDelim(']') {
+						out.Quote = make([]struct {
+							Open   []opt.Float64 `json:"open"`
+							Low    []opt.Float64 `json:"low"`
+							Volume []opt.Int     `json:"volume"`
+							High   []opt.Float64 `json:"high"`
+							Close  []opt.Float64 `json:"close"`
+						}, 0, 0)
+					} else {
+						out.Quote = []struct {
+							Open   []opt.Float64 `json:"open"`
+							Low    []opt.Float64 `json:"low"`
+							Volume []opt.Int     `json:"volume"`
+							High   []opt.Float64 `json:"high"`
+							Close  []opt.Float64 `json:"close"`
+						}{}
+					}
+				} else {
+					out.Quote = (out.Quote)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v31 struct {
+						Open   []opt.Float64 `json:"open"`
+						Low    []opt.Float64 `json:"low"`
+						Volume []opt.Int     `json:"volume"`
+						High   []opt.Float64 `json:"high"`
+						Close  []opt.Float64 `json:"close"`
+					}
+					easyjsonEc607727Decode6(in, &v31)
+					out.Quote = append(out.Quote, v31)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "adjclose":
+			if in.IsNull() {
+				in.Skip()
+				out.Adjclose = nil
+			} else {
+				in.Delim('[')
+				if out.Adjclose == nil {
+					if !in.IsDelim(']') {
+						out.Adjclose = make([]struct {
+							Adjclose []opt.Float64 `json:"adjclose"`
+						}, 0, 2)
+					} else {
+						out.Adjclose = []struct {
+							Adjclose []opt.Float64 `json:"adjclose"`
+						}{}
+					}
+				} else {
+					out.Adjclose = (out.Adjclose)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v32 struct {
+						Adjclose []opt.Float64 `json:"adjclose"`
+					}
+					easyjsonEc607727Decode7(in, &v32)
+					out.Adjclose = append(out.Adjclose, v32)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonEc607727Encode5(out *jwriter.Writer, in struct {
+	Quote []struct {
+		Open   []opt.Float64 `json:"open"`
+		Low    []opt.Float64 `json:"low"`
+		Volume []opt.Int     `json:"volume"`
+		High   []opt.Float64 `json:"high"`
+		Close  []opt.Float64 `json:"close"`
+	} `json:"quote"`
+	Adjclose []struct {
+		Adjclose []opt.Float64 `json:"adjclose"`
+	} `json:"adjclose"`
+}) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"quote\":"
+		out.RawString(prefix[1:])
+		if in.Quote == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v33, v34 := range in.Quote {
+				if v33 > 0 {
 					out.RawByte(',')
 				}
-				out.Int(int(v9))
+				easyjsonEc607727Encode6(out, v34)
 			}
 			out.RawByte(']')
 		}
 	}
 	{
-		const prefix string = ",\"indicators\":"
+		const prefix string = ",\"adjclose\":"
 		out.RawString(prefix)
-		easyjsonEc607727Encode3(out, in.Indicators)
+		if in.Adjclose == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v35, v36 := range in.Adjclose {
+				if v35 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonEc607727Encode7(out, v36)
+			}
+			out.RawByte(']')
+		}
 	}
 	out.RawByte('}')
 }
-func easyjsonEc607727Decode3(in *jlexer.Lexer, out *struct {
-	Quote []struct {
-		Open   []float64 `json:"open"`
-		Low    []float64 `json:"low"`
-		Volume []int     `json:"volume"`
-		High   []float64 `json:"high"`
-		Close  []float64 `json:"close"`
-	} `json:"quote"`
+func easyjsonEc607727Decode7(in *jlexer.Lexer, out *struct {
+	Adjclose []opt.Float64 `json:"adjclose"`
 }) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
@@ -909,43 +1968,25 @@ func easyjsonEc607727Decode3(in *jlexer.Lexer, out *struct {
 			continue
 		}
 		switch key {
-		case "quote":
+		case "adjclose":
 			if in.IsNull() {
 				in.Skip()
-				out.Quote = nil
+				out.Adjclose = nil
 			} else {
 				in.Delim('[')
-				if out.Quote == nil {
+				if out.Adjclose == nil {
 					if !in.IsDelim(']') {
-						out.Quote = make([]struct {
-							Open   []float64 `json:"open"`
-							Low    []float64 `json:"low"`
-							Volume []int     `json:"volume"`
-							High   []float64 `json:"high"`
-							Close  []float64 `json:"close"`
-						}, 0, 0)
+						out.Adjclose = make([]opt.Float64, 0, 4)
 					} else {
-						out.Quote = []struct {
-							Open   []float64 `json:"open"`
-							Low    []float64 `json:"low"`
-							Volume []int     `json:"volume"`
-							High   []float64 `json:"high"`
-							Close  []float64 `json:"close"`
-						}{}
+						out.Adjclose = []opt.Float64{}
 					}
 				} else {
-					out.Quote = (out.Quote)[:0]
+					out.Adjclose = (out.Adjclose)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v10 struct {
-						Open   []float64 `json:"open"`
-						Low    []float64 `json:"low"`
-						Volume []int     `json:"volume"`
-						High   []float64 `json:"high"`
-						Close  []float64 `json:"close"`
-					}
-					easyjsonEc607727Decode4(in, &v10)
-					out.Quote = append(out.Quote, v10)
+					var v37 opt.Float64
+					(v37).UnmarshalEasyJSON(in)
+					out.Adjclose = append(out.Adjclose, v37)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -960,42 +2001,36 @@ func easyjsonEc607727Decode3(in *jlexer.Lexer, out *struct {
 		in.Consumed()
 	}
 }
-func easyjsonEc607727Encode3(out *jwriter.Writer, in struct {
-	Quote []struct {
-		Open   []float64 `json:"open"`
-		Low    []float64 `json:"low"`
-		Volume []int     `json:"volume"`
-		High   []float64 `json:"high"`
-		Close  []float64 `json:"close"`
-	} `json:"quote"`
+func easyjsonEc607727Encode7(out *jwriter.Writer, in struct {
+	Adjclose []opt.Float64 `json:"adjclose"`
 }) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"quote\":"
+		const prefix string = ",\"adjclose\":"
 		out.RawString(prefix[1:])
-		if in.Quote == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		if in.Adjclose == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v11, v12 := range in.Quote {
-				if v11 > 0 {
+			for v38, v39 := range in.Adjclose {
+				if v38 > 0 {
 					out.RawByte(',')
 				}
-				easyjsonEc607727Encode4(out, v12)
+				(v39).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
 	}
 	out.RawByte('}')
 }
-func easyjsonEc607727Decode4(in *jlexer.Lexer, out *struct {
-	Open   []float64 `json:"open"`
-	Low    []float64 `json:"low"`
-	Volume []int     `json:"volume"`
-	High   []float64 `json:"high"`
-	Close  []float64 `json:"close"`
+func easyjsonEc607727Decode6(in *jlexer.Lexer, out *struct {
+	Open   []opt.Float64 `json:"open"`
+	Low    []opt.Float64 `json:"low"`
+	Volume []opt.Int     `json:"volume"`
+	High   []opt.Float64 `json:"high"`
+	Close  []opt.Float64 `json:"close"`
 }) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
@@ -1023,17 +2058,17 @@ func easyjsonEc607727Decode4(in *jlexer.Lexer, out *struct {
 				in.Delim('[')
 				if out.Open == nil {
 					if !in.IsDelim(']') {
-						out.Open = make([]float64, 0, 8)
+						out.Open = make([]opt.Float64, 0, 4)
 					} else {
-						out.Open = []float64{}
+						out.Open = []opt.Float64{}
 					}
 				} else {
 					out.Open = (out.Open)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v13 float64
-					v13 = float64(in.Float64())
-					out.Open = append(out.Open, v13)
+					var v40 opt.Float64
+					(v40).UnmarshalEasyJSON(in)
+					out.Open = append(out.Open, v40)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -1046,17 +2081,17 @@ func easyjsonEc607727Decode4(in *jlexer.Lexer, out *struct {
 				in.Delim('[')
 				if out.Low == nil {
 					if !in.IsDelim(']') {
-						out.Low = make([]float64, 0, 8)
+						out.Low = make([]opt.Float64, 0, 4)
 					} else {
-						out.Low = []float64{}
+						out.Low = []opt.Float64{}
 					}
 				} else {
 					out.Low = (out.Low)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v14 float64
-					v14 = float64(in.Float64())
-					out.Low = append(out.Low, v14)
+					var v41 opt.Float64
+					(v41).UnmarshalEasyJSON(in)
+					out.Low = append(out.Low, v41)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -1069,17 +2104,17 @@ func easyjsonEc607727Decode4(in *jlexer.Lexer, out *struct {
 				in.Delim('[')
 				if out.Volume == nil {
 					if !in.IsDelim(']') {
-						out.Volume = make([]int, 0, 8)
+						out.Volume = make([]opt.Int, 0, 4)
 					} else {
-						out.Volume = []int{}
+						out.Volume = []opt.Int{}
 					}
 				} else {
 					out.Volume = (out.Volume)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v15 int
-					v15 = int(in.Int())
-					out.Volume = append(out.Volume, v15)
+					var v42 opt.Int
+					(v42).UnmarshalEasyJSON(in)
+					out.Volume = append(out.Volume, v42)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -1092,17 +2127,17 @@ func easyjsonEc607727Decode4(in *jlexer.Lexer, out *struct {
 				in.Delim('[')
 				if out.High == nil {
 					if !in.IsDelim(']') {
-						out.High = make([]float64, 0, 8)
+						out.High = make([]opt.Float64, 0, 4)
 					} else {
-						out.High = []float64{}
+						out.High = []opt.Float64{}
 					}
 				} else {
 					out.High = (out.High)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v16 float64
-					v16 = float64(in.Float64())
-					out.High = append(out.High, v16)
+					var v43 opt.Float64
+					(v43).UnmarshalEasyJSON(in)
+					out.High = append(out.High, v43)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -1115,17 +2150,17 @@ func easyjsonEc607727Decode4(in *jlexer.Lexer, out *struct {
 				in.Delim('[')
 				if out.Close == nil {
 					if !in.IsDelim(']') {
-						out.Close = make([]float64, 0, 8)
+						out.Close = make([]opt.Float64, 0, 4)
 					} else {
-						out.Close = []float64{}
+						out.Close = []opt.Float64{}
 					}
 				} else {
 					out.Close = (out.Close)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v17 float64
-					v17 = float64(in.Float64())
-					out.Close = append(out.Close, v17)
+					var v44 opt.Float64
+					(v44).UnmarshalEasyJSON(in)
+					out.Close = append(out.Close, v44)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -1140,12 +2175,12 @@ func easyjsonEc607727Decode4(in *jlexer.Lexer, out *struct {
 		in.Consumed()
 	}
 }
-func easyjsonEc607727Encode4(out *jwriter.Writer, in struct {
-	Open   []float64 `json:"open"`
-	Low    []float64 `json:"low"`
-	Volume []int     `json:"volume"`
-	High   []float64 `json:"high"`
-	Close  []float64 `json:"close"`
+func easyjsonEc607727Encode6(out *jwriter.Writer, in struct {
+	Open   []opt.Float64 `json:"open"`
+	Low    []opt.Float64 `json:"low"`
+	Volume []opt.Int     `json:"volume"`
+	High   []opt.Float64 `json:"high"`
+	Close  []opt.Float64 `json:"close"`
 }) {
 	out.RawByte('{')
 	first := true
@@ -1157,11 +2192,11 @@ func easyjsonEc607727Encode4(out *jwriter.Writer, in struct {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v18, v19 := range in.Open {
-				if v18 > 0 {
+			for v45, v46 := range in.Open {
+				if v45 > 0 {
 					out.RawByte(',')
 				}
-				out.Float64(float64(v19))
+				(v46).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -1173,11 +2208,11 @@ func easyjsonEc607727Encode4(out *jwriter.Writer, in struct {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v20, v21 := range in.Low {
-				if v20 > 0 {
+			for v47, v48 := range in.Low {
+				if v47 > 0 {
 					out.RawByte(',')
 				}
-				out.Float64(float64(v21))
+				(v48).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -1189,11 +2224,11 @@ func easyjsonEc607727Encode4(out *jwriter.Writer, in struct {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v22, v23 := range in.Volume {
-				if v22 > 0 {
+			for v49, v50 := range in.Volume {
+				if v49 > 0 {
 					out.RawByte(',')
 				}
-				out.Int(int(v23))
+				(v50).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -1205,11 +2240,11 @@ func easyjsonEc607727Encode4(out *jwriter.Writer, in struct {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v24, v25 := range in.High {
-				if v24 > 0 {
+			for v51, v52 := range in.High {
+				if v51 > 0 {
 					out.RawByte(',')
 				}
-				out.Float64(float64(v25))
+				(v52).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -1221,18 +2256,344 @@ func easyjsonEc607727Encode4(out *jwriter.Writer, in struct {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v26, v27 := range in.Close {
-				if v26 > 0 {
+			for v53, v54 := range in.Close {
+				if v53 > 0 {
 					out.RawByte(',')
 				}
-				out.Float64(float64(v27))
+				(v54).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
 	}
 	out.RawByte('}')
 }
-func easyjsonEc607727Decode2(in *jlexer.Lexer, out *struct {
+func easyjsonEc607727Decode4(in *jlexer.Lexer, out *struct {
+	Dividends map[string]struct {
+		Amount float64 `json:"amount"`
+		Date   int64   `json:"date"`
+	} `json:"dividends"`
+	Splits map[string]struct {
+		Date        int64   `json:"date"`
+		Numerator   float64 `json:"numerator"`
+		Denominator float64 `json:"denominator"`
+		SplitRatio  string  `json:"splitRatio"`
+	} `json:"splits"`
+	CapitalGains map[string]struct {
+		Amount float64 `json:"amount"`
+		Date   int64   `json:"date"`
+	} `json:"capitalGains"`
+}) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "dividends":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				out.Dividends = make(map[string]struct {
+					Amount float64 `json:"amount"`
+					Date   int64   `json:"date"`
+				})
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v55 struct {
+						Amount float64 `json:"amount"`
+						Date   int64   `json:"date"`
+					}
+					easyjsonEc607727Decode8(in, &v55)
+					(out.Dividends)[key] = v55
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "splits":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				out.Splits = make(map[string]struct {
+					Date        int64   `json:"date"`
+					Numerator   float64 `json:"numerator"`
+					Denominator float64 `json:"denominator"`
+					SplitRatio  string  `json:"splitRatio"`
+				})
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v56 struct {
+						Date        int64   `json:"date"`
+						Numerator   float64 `json:"numerator"`
+						Denominator float64 `json:"denominator"`
+						SplitRatio  string  `json:"splitRatio"`
+					}
+					easyjsonEc607727Decode9(in, &v56)
+					(out.Splits)[key] = v56
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "capitalGains":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				out.CapitalGains = make(map[string]struct {
+					Amount float64 `json:"amount"`
+					Date   int64   `json:"date"`
+				})
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v57 struct {
+						Amount float64 `json:"amount"`
+						Date   int64   `json:"date"`
+					}
+					easyjsonEc607727Decode8(in, &v57)
+					(out.CapitalGains)[key] = v57
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonEc607727Encode4(out *jwriter.Writer, in struct {
+	Dividends map[string]struct {
+		Amount float64 `json:"amount"`
+		Date   int64   `json:"date"`
+	} `json:"dividends"`
+	Splits map[string]struct {
+		Date        int64   `json:"date"`
+		Numerator   float64 `json:"numerator"`
+		Denominator float64 `json:"denominator"`
+		SplitRatio  string  `json:"splitRatio"`
+	} `json:"splits"`
+	CapitalGains map[string]struct {
+		Amount float64 `json:"amount"`
+		Date   int64   `json:"date"`
+	} `json:"capitalGains"`
+}) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"dividends\":"
+		out.RawString(prefix[1:])
+		if in.Dividends == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v58First := true
+			for v58Name, v58Value := range in.Dividends {
+				if v58First {
+					v58First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v58Name))
+				out.RawByte(':')
+				easyjsonEc607727Encode8(out, v58Value)
+			}
+			out.RawByte('}')
+		}
+	}
+	{
+		const prefix string = ",\"splits\":"
+		out.RawString(prefix)
+		if in.Splits == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v59First := true
+			for v59Name, v59Value := range in.Splits {
+				if v59First {
+					v59First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v59Name))
+				out.RawByte(':')
+				easyjsonEc607727Encode9(out, v59Value)
+			}
+			out.RawByte('}')
+		}
+	}
+	{
+		const prefix string = ",\"capitalGains\":"
+		out.RawString(prefix)
+		if in.CapitalGains == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v60First := true
+			for v60Name, v60Value := range in.CapitalGains {
+				if v60First {
+					v60First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v60Name))
+				out.RawByte(':')
+				easyjsonEc607727Encode8(out, v60Value)
+			}
+			out.RawByte('}')
+		}
+	}
+	out.RawByte('}')
+}
+func easyjsonEc607727Decode9(in *jlexer.Lexer, out *struct {
+	Date        int64   `json:"date"`
+	Numerator   float64 `json:"numerator"`
+	Denominator float64 `json:"denominator"`
+	SplitRatio  string  `json:"splitRatio"`
+}) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "date":
+			out.Date = int64(in.Int64())
+		case "numerator":
+			out.Numerator = float64(in.Float64())
+		case "denominator":
+			out.Denominator = float64(in.Float64())
+		case "splitRatio":
+			out.SplitRatio = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonEc607727Encode9(out *jwriter.Writer, in struct {
+	Date        int64   `json:"date"`
+	Numerator   float64 `json:"numerator"`
+	Denominator float64 `json:"denominator"`
+	SplitRatio  string  `json:"splitRatio"`
+}) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"date\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.Date))
+	}
+	{
+		const prefix string = ",\"numerator\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Numerator))
+	}
+	{
+		const prefix string = ",\"denominator\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Denominator))
+	}
+	{
+		const prefix string = ",\"splitRatio\":"
+		out.RawString(prefix)
+		out.String(string(in.SplitRatio))
+	}
+	out.RawByte('}')
+}
+func easyjsonEc607727Decode8(in *jlexer.Lexer, out *struct {
+	Amount float64 `json:"amount"`
+	Date   int64   `json:"date"`
+}) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "amount":
+			out.Amount = float64(in.Float64())
+		case "date":
+			out.Date = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonEc607727Encode8(out *jwriter.Writer, in struct {
+	Amount float64 `json:"amount"`
+	Date   int64   `json:"date"`
+}) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"amount\":"
+		out.RawString(prefix[1:])
+		out.Float64(float64(in.Amount))
+	}
+	{
+		const prefix string = ",\"date\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Date))
+	}
+	out.RawByte('}')
+}
+func easyjsonEc607727Decode3(in *jlexer.Lexer, out *struct {
 	Currency             string  `json:"currency"`
 	Symbol               string  `json:"symbol"`
 	ExchangeName         string  `json:"exchangeName"`
@@ -1248,34 +2609,14 @@ func easyjsonEc607727Decode2(in *jlexer.Lexer, out *struct {
 	Scale                int     `json:"scale"`
 	PriceHint            int     `json:"priceHint"`
 	CurrentTradingPeriod struct {
-		Pre struct {
-			Timezone  string `json:"timezone"`
-			Start     int    `json:"start"`
-			End       int    `json:"end"`
-			Gmtoffset int    `json:"gmtoffset"`
-		} `json:"pre"`
-		Regular struct {
-			Timezone  string `json:"timezone"`
-			Start     int    `json:"start"`
-			End       int    `json:"end"`
-			Gmtoffset int    `json:"gmtoffset"`
-		} `json:"regular"`
-		Post struct {
-			Timezone  string `json:"timezone"`
-			Start     int    `json:"start"`
-			End       int    `json:"end"`
-			Gmtoffset int    `json:"gmtoffset"`
-		} `json:"post"`
+		Pre     JSONTradingPeriod `json:"pre"`
+		Regular JSONTradingPeriod `json:"regular"`
+		Post    JSONTradingPeriod `json:"post"`
 	} `json:"currentTradingPeriod"`
-	TradingPeriods [][]struct {
-		Timezone  string `json:"timezone"`
-		Start     int    `json:"start"`
-		End       int    `json:"end"`
-		Gmtoffset int    `json:"gmtoffset"`
-	} `json:"tradingPeriods"`
-	DataGranularity string   `json:"dataGranularity"`
-	Range           string   `json:"range"`
-	ValidRanges     []string `json:"validRanges"`
+	TradingPeriods  JSONTradingPeriods `json:"tradingPeriods"`
+	DataGranularity string             `json:"dataGranularity"`
+	Range           string             `json:"range"`
+	ValidRanges     []string           `json:"validRanges"`
 }) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
@@ -1304,101 +2645,29 @@ func easyjsonEc607727Decode2(in *jlexer.Lexer, out *struct {
 		case "instrumentType":
 			out.InstrumentType = string(in.String())
 		case "firstTradeDate":
-			out.FirstTradeDate = int(in.Int())
-		case "regularMarketTime":
-			out.RegularMarketTime = int(in.Int())
-		case "gmtoffset":
-			out.Gmtoffset = int(in.Int())
-		case "timezone":
-			out.Timezone = string(in.String())
-		case "exchangeTimezoneName":
-			out.ExchangeTimezoneName = string(in.String())
-		case "regularMarketPrice":
-			out.RegularMarketPrice = float64(in.Float64())
-		case "chartPreviousClose":
-			out.ChartPreviousClose = float64(in.Float64())
-		case "previousClose":
-			out.PreviousClose = float64(in.Float64())
-		case "scale":
-			out.Scale = int(in.Int())
-		case "priceHint":
-			out.PriceHint = int(in.Int())
-		case "currentTradingPeriod":
-			easyjsonEc607727Decode5(in, &out.CurrentTradingPeriod)
-		case "tradingPeriods":
-			if in.IsNull() {
-				in.Skip()
-				out.TradingPeriods = nil
-			} else {
-				in.Delim('[')
-				if out.TradingPeriods == nil {
-					if !in.IsDelim(']') {
-						out.TradingPeriods = make([][]struct {
-							Timezone  string `json:"timezone"`
-							Start     int    `json:"start"`
-							End       int    `json:"end"`
-							Gmtoffset int    `json:"gmtoffset"`
-						}, 0, 2)
-					} else {
-						out.TradingPeriods = [][]struct {
-							Timezone  string `json:"timezone"`
-							Start     int    `json:"start"`
-							End       int    `json:"end"`
-							Gmtoffset int    `json:"gmtoffset"`
-						}{}
-					}
-				} else {
-					out.TradingPeriods = (out.TradingPeriods)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v28 []struct {
-						Timezone  string `json:"timezone"`
-						Start     int    `json:"start"`
-						End       int    `json:"end"`
-						Gmtoffset int    `json:"gmtoffset"`
-					}
-					if in.IsNull() {
-						in.Skip()
-						v28 = nil
-					} else {
-						in.Delim('[')
-						if v28 == nil {
-							if !in.IsDelim(']') {
-								v28 = make([]struct {
-									Timezone  string `json:"timezone"`
-									Start     int    `json:"start"`
-									End       int    `json:"end"`
-									Gmtoffset int    `json:"gmtoffset"`
-								}, 0, 1)
-							} else {
-								v28 = []struct {
-									Timezone  string `json:"timezone"`
-									Start     int    `json:"start"`
-									End       int    `json:"end"`
-									Gmtoffset int    `json:"gmtoffset"`
-								}{}
-							}
-						} else {
-							v28 = (v28)[:0]
-						}
-						for !in.IsDelim(']') {
-							var v29 struct {
-								Timezone  string `json:"timezone"`
-								Start     int    `json:"start"`
-								End       int    `json:"end"`
-								Gmtoffset int    `json:"gmtoffset"`
-							}
-							easyjsonEc607727Decode6(in, &v29)
-							v28 = append(v28, v29)
-							in.WantComma()
-						}
-						in.Delim(']')
-					}
-					out.TradingPeriods = append(out.TradingPeriods, v28)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
+			out.FirstTradeDate = int(in.Int())
+		case "regularMarketTime":
+			out.RegularMarketTime = int(in.Int())
+		case "gmtoffset":
+			out.Gmtoffset = int(in.Int())
+		case "timezone":
+			out.Timezone = string(in.String())
+		case "exchangeTimezoneName":
+			out.ExchangeTimezoneName = string(in.String())
+		case "regularMarketPrice":
+			out.RegularMarketPrice = float64(in.Float64())
+		case "chartPreviousClose":
+			out.ChartPreviousClose = float64(in.Float64())
+		case "previousClose":
+			out.PreviousClose = float64(in.Float64())
+		case "scale":
+			out.Scale = int(in.Int())
+		case "priceHint":
+			out.PriceHint = int(in.Int())
+		case "currentTradingPeriod":
+			easyjsonEc607727Decode10(in, &out.CurrentTradingPeriod)
+		case "tradingPeriods":
+			(out.TradingPeriods).UnmarshalEasyJSON(in)
 		case "dataGranularity":
 			out.DataGranularity = string(in.String())
 		case "range":
@@ -1419,9 +2688,9 @@ func easyjsonEc607727Decode2(in *jlexer.Lexer, out *struct {
 					out.ValidRanges = (out.ValidRanges)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v30 string
-					v30 = string(in.String())
-					out.ValidRanges = append(out.ValidRanges, v30)
+					var v61 string
+					v61 = string(in.String())
+					out.ValidRanges = append(out.ValidRanges, v61)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -1436,7 +2705,7 @@ func easyjsonEc607727Decode2(in *jlexer.Lexer, out *struct {
 		in.Consumed()
 	}
 }
-func easyjsonEc607727Encode2(out *jwriter.Writer, in struct {
+func easyjsonEc607727Encode3(out *jwriter.Writer, in struct {
 	Currency             string  `json:"currency"`
 	Symbol               string  `json:"symbol"`
 	ExchangeName         string  `json:"exchangeName"`
@@ -1452,34 +2721,14 @@ func easyjsonEc607727Encode2(out *jwriter.Writer, in struct {
 	Scale                int     `json:"scale"`
 	PriceHint            int     `json:"priceHint"`
 	CurrentTradingPeriod struct {
-		Pre struct {
-			Timezone  string `json:"timezone"`
-			Start     int    `json:"start"`
-			End       int    `json:"end"`
-			Gmtoffset int    `json:"gmtoffset"`
-		} `json:"pre"`
-		Regular struct {
-			Timezone  string `json:"timezone"`
-			Start     int    `json:"start"`
-			End       int    `json:"end"`
-			Gmtoffset int    `json:"gmtoffset"`
-		} `json:"regular"`
-		Post struct {
-			Timezone  string `json:"timezone"`
-			Start     int    `json:"start"`
-			End       int    `json:"end"`
-			Gmtoffset int    `json:"gmtoffset"`
-		} `json:"post"`
+		Pre     JSONTradingPeriod `json:"pre"`
+		Regular JSONTradingPeriod `json:"regular"`
+		Post    JSONTradingPeriod `json:"post"`
 	} `json:"currentTradingPeriod"`
-	TradingPeriods [][]struct {
-		Timezone  string `json:"timezone"`
-		Start     int    `json:"start"`
-		End       int    `json:"end"`
-		Gmtoffset int    `json:"gmtoffset"`
-	} `json:"tradingPeriods"`
-	DataGranularity string   `json:"dataGranularity"`
-	Range           string   `json:"range"`
-	ValidRanges     []string `json:"validRanges"`
+	TradingPeriods  JSONTradingPeriods `json:"tradingPeriods"`
+	DataGranularity string             `json:"dataGranularity"`
+	Range           string             `json:"range"`
+	ValidRanges     []string           `json:"validRanges"`
 }) {
 	out.RawByte('{')
 	first := true
@@ -1557,34 +2806,12 @@ func easyjsonEc607727Encode2(out *jwriter.Writer, in struct {
 	{
 		const prefix string = ",\"currentTradingPeriod\":"
 		out.RawString(prefix)
-		easyjsonEc607727Encode5(out, in.CurrentTradingPeriod)
+		easyjsonEc607727Encode10(out, in.CurrentTradingPeriod)
 	}
 	{
 		const prefix string = ",\"tradingPeriods\":"
 		out.RawString(prefix)
-		if in.TradingPeriods == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v31, v32 := range in.TradingPeriods {
-				if v31 > 0 {
-					out.RawByte(',')
-				}
-				if v32 == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-					out.RawString("null")
-				} else {
-					out.RawByte('[')
-					for v33, v34 := range v32 {
-						if v33 > 0 {
-							out.RawByte(',')
-						}
-						easyjsonEc607727Encode6(out, v34)
-					}
-					out.RawByte(']')
-				}
-			}
-			out.RawByte(']')
-		}
+		(in.TradingPeriods).MarshalEasyJSON(out)
 	}
 	{
 		const prefix string = ",\"dataGranularity\":"
@@ -1603,22 +2830,21 @@ func easyjsonEc607727Encode2(out *jwriter.Writer, in struct {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v35, v36 := range in.ValidRanges {
-				if v35 > 0 {
+			for v62, v63 := range in.ValidRanges {
+				if v62 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v36))
+				out.String(string(v63))
 			}
 			out.RawByte(']')
 		}
 	}
 	out.RawByte('}')
 }
-func easyjsonEc607727Decode6(in *jlexer.Lexer, out *struct {
-	Timezone  string `json:"timezone"`
-	Start     int    `json:"start"`
-	End       int    `json:"end"`
-	Gmtoffset int    `json:"gmtoffset"`
+func easyjsonEc607727Decode10(in *jlexer.Lexer, out *struct {
+	Pre     JSONTradingPeriod `json:"pre"`
+	Regular JSONTradingPeriod `json:"regular"`
+	Post    JSONTradingPeriod `json:"post"`
 }) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
@@ -1638,14 +2864,12 @@ func easyjsonEc607727Decode6(in *jlexer.Lexer, out *struct {
 			continue
 		}
 		switch key {
-		case "timezone":
-			out.Timezone = string(in.String())
-		case "start":
-			out.Start = int(in.Int())
-		case "end":
-			out.End = int(in.Int())
-		case "gmtoffset":
-			out.Gmtoffset = int(in.Int())
+		case "pre":
+			(out.Pre).UnmarshalEasyJSON(in)
+		case "regular":
+			(out.Regular).UnmarshalEasyJSON(in)
+		case "post":
+			(out.Post).UnmarshalEasyJSON(in)
 		default:
 			in.SkipRecursive()
 		}
@@ -1656,57 +2880,105 @@ func easyjsonEc607727Decode6(in *jlexer.Lexer, out *struct {
 		in.Consumed()
 	}
 }
-func easyjsonEc607727Encode6(out *jwriter.Writer, in struct {
-	Timezone  string `json:"timezone"`
-	Start     int    `json:"start"`
-	End       int    `json:"end"`
-	Gmtoffset int    `json:"gmtoffset"`
+func easyjsonEc607727Encode10(out *jwriter.Writer, in struct {
+	Pre     JSONTradingPeriod `json:"pre"`
+	Regular JSONTradingPeriod `json:"regular"`
+	Post    JSONTradingPeriod `json:"post"`
 }) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"timezone\":"
+		const prefix string = ",\"pre\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Timezone))
+		(in.Pre).MarshalEasyJSON(out)
 	}
 	{
-		const prefix string = ",\"start\":"
+		const prefix string = ",\"regular\":"
 		out.RawString(prefix)
-		out.Int(int(in.Start))
+		(in.Regular).MarshalEasyJSON(out)
 	}
 	{
-		const prefix string = ",\"end\":"
+		const prefix string = ",\"post\":"
 		out.RawString(prefix)
-		out.Int(int(in.End))
+		(in.Post).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+func easyjsonEc607727DecodeGithubComZeteliasGoyfinance8(in *jlexer.Lexer, out *JSONError) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "code":
+			out.Code = string(in.String())
+		case "description":
+			out.Description = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonEc607727EncodeGithubComZeteliasGoyfinance8(out *jwriter.Writer, in JSONError) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"code\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Code))
 	}
 	{
-		const prefix string = ",\"gmtoffset\":"
+		const prefix string = ",\"description\":"
 		out.RawString(prefix)
-		out.Int(int(in.Gmtoffset))
+		out.String(string(in.Description))
 	}
 	out.RawByte('}')
 }
-func easyjsonEc607727Decode5(in *jlexer.Lexer, out *struct {
-	Pre struct {
-		Timezone  string `json:"timezone"`
-		Start     int    `json:"start"`
-		End       int    `json:"end"`
-		Gmtoffset int    `json:"gmtoffset"`
-	} `json:"pre"`
-	Regular struct {
-		Timezone  string `json:"timezone"`
-		Start     int    `json:"start"`
-		End       int    `json:"end"`
-		Gmtoffset int    `json:"gmtoffset"`
-	} `json:"regular"`
-	Post struct {
-		Timezone  string `json:"timezone"`
-		Start     int    `json:"start"`
-		End       int    `json:"end"`
-		Gmtoffset int    `json:"gmtoffset"`
-	} `json:"post"`
-}) {
+
+// MarshalJSON supports json.Marshaler interface
+func (v JSONError) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonEc607727EncodeGithubComZeteliasGoyfinance8(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v JSONError) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonEc607727EncodeGithubComZeteliasGoyfinance8(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *JSONError) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonEc607727DecodeGithubComZeteliasGoyfinance8(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *JSONError) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonEc607727DecodeGithubComZeteliasGoyfinance8(l, v)
+}
+func easyjsonEc607727DecodeGithubComZeteliasGoyfinance9(in *jlexer.Lexer, out *Dividend) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1725,12 +2997,14 @@ func easyjsonEc607727Decode5(in *jlexer.Lexer, out *struct {
 			continue
 		}
 		switch key {
-		case "pre":
-			easyjsonEc607727Decode6(in, &out.Pre)
-		case "regular":
-			easyjsonEc607727Decode6(in, &out.Regular)
-		case "post":
-			easyjsonEc607727Decode6(in, &out.Post)
+		case "Time":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Time).UnmarshalJSON(data))
+			}
+		case "Timestamp":
+			out.Timestamp = int64(in.Int64())
+		case "Amount":
+			out.Amount = float64(in.Float64())
 		default:
 			in.SkipRecursive()
 		}
@@ -1741,43 +3015,210 @@ func easyjsonEc607727Decode5(in *jlexer.Lexer, out *struct {
 		in.Consumed()
 	}
 }
-func easyjsonEc607727Encode5(out *jwriter.Writer, in struct {
-	Pre struct {
-		Timezone  string `json:"timezone"`
-		Start     int    `json:"start"`
-		End       int    `json:"end"`
-		Gmtoffset int    `json:"gmtoffset"`
-	} `json:"pre"`
-	Regular struct {
-		Timezone  string `json:"timezone"`
-		Start     int    `json:"start"`
-		End       int    `json:"end"`
-		Gmtoffset int    `json:"gmtoffset"`
-	} `json:"regular"`
-	Post struct {
-		Timezone  string `json:"timezone"`
-		Start     int    `json:"start"`
-		End       int    `json:"end"`
-		Gmtoffset int    `json:"gmtoffset"`
-	} `json:"post"`
-}) {
+func easyjsonEc607727EncodeGithubComZeteliasGoyfinance9(out *jwriter.Writer, in Dividend) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"pre\":"
+		const prefix string = ",\"Time\":"
 		out.RawString(prefix[1:])
-		easyjsonEc607727Encode6(out, in.Pre)
+		out.Raw((in.Time).MarshalJSON())
 	}
 	{
-		const prefix string = ",\"regular\":"
+		const prefix string = ",\"Timestamp\":"
 		out.RawString(prefix)
-		easyjsonEc607727Encode6(out, in.Regular)
+		out.Int64(int64(in.Timestamp))
 	}
 	{
-		const prefix string = ",\"post\":"
+		const prefix string = ",\"Amount\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Amount))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Dividend) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonEc607727EncodeGithubComZeteliasGoyfinance9(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Dividend) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonEc607727EncodeGithubComZeteliasGoyfinance9(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Dividend) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonEc607727DecodeGithubComZeteliasGoyfinance9(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Dividend) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonEc607727DecodeGithubComZeteliasGoyfinance9(l, v)
+}
+func easyjsonEc607727DecodeGithubComZeteliasGoyfinance10(in *jlexer.Lexer, out *CurrentTradingPeriod) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "Pre":
+			(out.Pre).UnmarshalEasyJSON(in)
+		case "Regular":
+			(out.Regular).UnmarshalEasyJSON(in)
+		case "Post":
+			(out.Post).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonEc607727EncodeGithubComZeteliasGoyfinance10(out *jwriter.Writer, in CurrentTradingPeriod) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Pre\":"
+		out.RawString(prefix[1:])
+		(in.Pre).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"Regular\":"
+		out.RawString(prefix)
+		(in.Regular).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"Post\":"
+		out.RawString(prefix)
+		(in.Post).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CurrentTradingPeriod) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonEc607727EncodeGithubComZeteliasGoyfinance10(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CurrentTradingPeriod) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonEc607727EncodeGithubComZeteliasGoyfinance10(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CurrentTradingPeriod) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonEc607727DecodeGithubComZeteliasGoyfinance10(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CurrentTradingPeriod) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonEc607727DecodeGithubComZeteliasGoyfinance10(l, v)
+}
+func easyjsonEc607727DecodeGithubComZeteliasGoyfinance11(in *jlexer.Lexer, out *CapitalGain) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "Time":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Time).UnmarshalJSON(data))
+			}
+		case "Timestamp":
+			out.Timestamp = int64(in.Int64())
+		case "Amount":
+			out.Amount = float64(in.Float64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonEc607727EncodeGithubComZeteliasGoyfinance11(out *jwriter.Writer, in CapitalGain) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Time\":"
+		out.RawString(prefix[1:])
+		out.Raw((in.Time).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"Timestamp\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Timestamp))
+	}
+	{
+		const prefix string = ",\"Amount\":"
 		out.RawString(prefix)
-		easyjsonEc607727Encode6(out, in.Post)
+		out.Float64(float64(in.Amount))
 	}
 	out.RawByte('}')
 }
+
+// MarshalJSON supports json.Marshaler interface
+func (v CapitalGain) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonEc607727EncodeGithubComZeteliasGoyfinance11(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CapitalGain) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonEc607727EncodeGithubComZeteliasGoyfinance11(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CapitalGain) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonEc607727DecodeGithubComZeteliasGoyfinance11(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CapitalGain) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonEc607727DecodeGithubComZeteliasGoyfinance11(l, v)
+}
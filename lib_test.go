@@ -0,0 +1,143 @@
+package goyfinance
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseJSONToQuoteTimes(t *testing.T) {
+	quote, err := parseJSONtoQuote([]byte(testChartJSON), "AAPL", 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	first := quote.PriceHistoric[0]
+	if first.Timestamp != 1704205800 {
+		t.Errorf("timestamp is %d", first.Timestamp)
+	}
+	if first.Time.Location().String() != "America/New_York" {
+		t.Errorf("location is %s", first.Time.Location())
+	}
+	if first.Time.Hour() != 9 || first.Time.Minute() != 30 || first.Time.Day() != 2 {
+		t.Errorf("time is %s", first.Time)
+	}
+}
+
+func TestParseJSONToQuoteNulls(t *testing.T) {
+	jsonData := `{"chart":{"result":[{"meta":{"exchangeTimezoneName":"America/New_York","dataGranularity":"1m"},"timestamp":[1704205800,1704205860,1704205920],"indicators":{"quote":[{"open":[187.15,null,187.2],"low":[187.0,null,187.1],"volume":[1000,null,900],"high":[187.3,null,187.4],"close":[187.25,null,187.3]}]}}],"error":null}}`
+	quote, err := parseJSONtoQuote([]byte(jsonData), "AAPL", 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(quote.PriceHistoric) != 3 || !quote.PriceHistoric[1].Missing || quote.PriceHistoric[0].Missing {
+		t.Fatalf("unexpected bars %+v", quote.PriceHistoric)
+	}
+
+	filled := fillMissingBars(append([]PriceData(nil), quote.PriceHistoric...), MissingBarsForwardFill)
+	if filled[1].OpenPrice != 187.25 || filled[1].ClosePrice != 187.25 || !filled[1].Missing {
+		t.Errorf("bar was not forward-filled: %+v", filled[1])
+	}
+
+	dropped := fillMissingBars(append([]PriceData(nil), quote.PriceHistoric...), MissingBarsDrop)
+	if len(dropped) != 2 || dropped[1].Timestamp != 1704205920 {
+		t.Errorf("bar was not dropped: %+v", dropped)
+	}
+}
+
+func TestQuoteAdjusted(t *testing.T) {
+	jsonData := `{"chart":{"result":[{"meta":{"dataGranularity":"1d"},"timestamp":[1704205800,1704292200],"indicators":{"quote":[{"open":[100,50],"low":[90,45],"volume":[10,20],"high":[110,55],"close":[100,50]}],"adjclose":[{"adjclose":[50,50]}]}}],"error":null}}`
+	quote, err := parseJSONtoQuote([]byte(jsonData), "TEST", 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if quote.PriceHistoric[0].AdjClosePrice != 50 {
+		t.Fatalf("adjusted close is %f", quote.PriceHistoric[0].AdjClosePrice)
+	}
+
+	adjusted := quote.Adjusted()
+	first := adjusted.PriceHistoric[0]
+	if first.OpenPrice != 50 || first.LowPrice != 45 || first.HighPrice != 55 || first.ClosePrice != 50 || first.Volume != 10 {
+		t.Errorf("unexpected adjusted bar %+v", first)
+	}
+	if quote.PriceHistoric[0].OpenPrice != 100 {
+		t.Errorf("Adjusted modified the quote")
+	}
+}
+
+func TestRanges(t *testing.T) {
+	now := time.Date(2024, 3, 15, 12, 0, 0, 0, time.UTC)
+	start, end, err := PeriodYtd.timestamps(now)
+	if err != nil || start != time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC).Unix() || end != now.Unix() {
+		t.Errorf("ytd is %d-%d, %v", start, end, err)
+	}
+
+	if _, _, err := Period("7w").timestamps(now); !errors.Is(err, ErrInvalidRange) {
+		t.Errorf("expected ErrInvalidRange for an unsupported period, got %v", err)
+	}
+
+	backtest := DateRange{
+		Start: time.Date(2015, 3, 1, 0, 0, 0, 0, time.UTC),
+		End:   time.Date(2015, 6, 30, 0, 0, 0, 0, time.UTC),
+	}
+	start, end, err = backtest.timestamps(now)
+	if err != nil || start != backtest.Start.Unix() || end != backtest.End.Unix() {
+		t.Errorf("date range is %d-%d, %v", start, end, err)
+	}
+
+	reversed := DateRange{Start: backtest.End, End: backtest.Start}
+	if _, err := GetQuote("AAPL", IntervalOneDay, reversed); !errors.Is(err, ErrInvalidRange) {
+		t.Errorf("expected ErrInvalidRange for a reversed date range, got %v", err)
+	}
+}
+
+func TestParseJSONToQuoteMeta(t *testing.T) {
+	quote, err := parseJSONtoQuote([]byte(testChartJSON), "AAPL", 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	meta := quote.Meta
+	if meta.Currency != "USD" || meta.ExchangeName != "NMS" || meta.InstrumentType != "EQUITY" {
+		t.Errorf("unexpected meta %+v", meta)
+	}
+	if meta.Location.String() != "America/New_York" || meta.GMTOffset != -5*time.Hour {
+		t.Errorf("location is %s with offset %s", meta.Location, meta.GMTOffset)
+	}
+	if meta.FirstTradeDate.Year() != 1980 || meta.RegularMarketPrice != 181.18 {
+		t.Errorf("first trade date is %s, price is %f", meta.FirstTradeDate, meta.RegularMarketPrice)
+	}
+	regular := meta.CurrentTradingPeriod.Regular
+	if regular.Start.Hour() != 9 || regular.Start.Minute() != 30 || regular.End.Hour() != 16 {
+		t.Errorf("regular session is %s-%s", regular.Start, regular.End)
+	}
+}
+
+func TestParseJSONToQuoteSessions(t *testing.T) {
+	// 2024-01-02 in New York: pre 04:00-09:30, regular 09:30-16:00, post 16:00-20:00.
+	prePost := `{"chart":{"result":[{"meta":{"exchangeTimezoneName":"America/New_York","timezone":"EST","gmtoffset":-18000,"dataGranularity":"1h",` +
+		`"tradingPeriods":{"pre":[[{"timezone":"EST","start":1704186000,"end":1704205800,"gmtoffset":-18000}]],"regular":[[{"timezone":"EST","start":1704205800,"end":1704229200,"gmtoffset":-18000}]],"post":[[{"timezone":"EST","start":1704229200,"end":1704243600,"gmtoffset":-18000}]]}},` +
+		`"timestamp":[1704186000,1704205800,1704229200],"indicators":{"quote":[{"open":[1,2,3],"low":[1,2,3],"volume":[1,2,3],"high":[1,2,3],"close":[1,2,3]}]}}],"error":null}}`
+	quote, err := parseJSONtoQuote([]byte(prePost), "AAPL", 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(quote.Meta.TradingPeriods) != 1 || len(quote.Meta.PreMarketPeriods) != 1 || len(quote.Meta.PostMarketPeriods) != 1 {
+		t.Fatalf("unexpected trading periods %+v", quote.Meta)
+	}
+	sessions := []Session{SessionPre, SessionRegular, SessionPost}
+	for i, session := range sessions {
+		if quote.PriceHistoric[i].Session != session {
+			t.Errorf("bar %d is in session %q, want %q", i, quote.PriceHistoric[i].Session, session)
+		}
+	}
+
+	regularOnly := strings.Replace(prePost, prePost[strings.Index(prePost, `"tradingPeriods"`):strings.Index(prePost, `,"timestamp"`)],
+		`"tradingPeriods":[[{"timezone":"EST","start":1704205800,"end":1704229200,"gmtoffset":-18000}]]}`, 1)
+	quote, err = parseJSONtoQuote([]byte(regularOnly), "AAPL", 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(quote.Meta.TradingPeriods) != 1 || quote.Meta.TradingPeriods[0].Start.Hour() != 9 {
+		t.Errorf("unexpected trading periods %+v", quote.Meta.TradingPeriods)
+	}
+}
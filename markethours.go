@@ -0,0 +1,224 @@
+package goyfinance
+
+import (
+	"context"
+	"time"
+)
+
+// MarketState is the state of the market of an exchange at a given time.
+type MarketState string
+
+const (
+	MarketPre    MarketState = "pre"    // Pre-market session
+	MarketOpen   MarketState = "open"   // Regular session
+	MarketPost   MarketState = "post"   // Post-market session
+	MarketClosed MarketState = "closed" // No session
+)
+
+// ExchangeStatus is the state of the market of an exchange at a given time,
+// with the next regular session.
+type ExchangeStatus struct {
+	State MarketState
+	// Start of the next regular session,
+	// which is the one of the next trading day if the market is open.
+	NextOpen time.Time
+	// End of the current regular session if the market is open,
+	// and of the session starting at NextOpen otherwise.
+	NextClose time.Time
+}
+
+// ExchangeCalendar is the trading hours of an exchange,
+// built from the metadata of a quote with NewExchangeCalendar.
+// It only needs the metadata, so it can be kept and used offline.
+// Trading days are assumed to be Monday to Friday,
+// and the days of Sessions are used instead of the daily hours when known.
+// Weekdays missing from Sessions, but between the first and the last of them,
+// are considered holidays.
+type ExchangeCalendar struct {
+	Exchange     string // For example "NMS"
+	TimezoneName string // IANA name of the timezone of the exchange
+	Timezone     string // Abbreviation of the timezone, used if TimezoneName is unknown
+	GMTOffset    time.Duration
+
+	// Daily hours as durations since midnight, in the timezone of the exchange.
+	// PreOpen equals Open and PostClose equals Close
+	// when the exchange has no extended hours.
+	PreOpen   time.Duration
+	Open      time.Duration
+	Close     time.Duration
+	PostClose time.Duration
+
+	// Known sessions, in chronological order.
+	Sessions []CurrentTradingPeriod
+}
+
+// NewExchangeCalendar returns the calendar of the exchange of a quote.
+// The daily hours come from meta.CurrentTradingPeriod,
+// and known sessions from meta.TradingPeriods, which Yahoo only sends
+// for intraday intervals, with meta.PreMarketPeriods and meta.PostMarketPeriods.
+func NewExchangeCalendar(meta QuoteMeta) ExchangeCalendar {
+	current := meta.CurrentTradingPeriod
+	calendar := ExchangeCalendar{
+		Exchange:     meta.ExchangeName,
+		TimezoneName: meta.ExchangeTimezoneName,
+		Timezone:     meta.Timezone,
+		GMTOffset:    meta.GMTOffset,
+		Open:         timeOfDay(current.Regular.Start),
+		Close:        timeOfDay(current.Regular.End),
+	}
+	calendar.PreOpen, calendar.PostClose = calendar.Open, calendar.Close
+	if !current.Pre.Start.IsZero() && current.Pre.Start.Before(current.Regular.Start) {
+		calendar.PreOpen = timeOfDay(current.Pre.Start)
+	}
+	if !current.Post.End.IsZero() && current.Post.End.After(current.Regular.End) {
+		calendar.PostClose = timeOfDay(current.Post.End)
+	}
+
+	for _, regular := range meta.TradingPeriods {
+		session := CurrentTradingPeriod{Regular: regular}
+		for _, pre := range meta.PreMarketPeriods {
+			if sameDay(pre.Start, regular.Start) {
+				session.Pre = pre
+			}
+		}
+		for _, post := range meta.PostMarketPeriods {
+			if sameDay(post.Start, regular.Start) {
+				session.Post = post
+			}
+		}
+		calendar.Sessions = append(calendar.Sessions, session)
+	}
+	return calendar
+}
+
+// Location returns the timezone of the exchange.
+func (cal ExchangeCalendar) Location() *time.Location {
+	return exchangeLocation(cal.TimezoneName, cal.Timezone, int(cal.GMTOffset/time.Second))
+}
+
+// Status returns the state of the market at t, with the next regular session.
+func (cal ExchangeCalendar) Status(t time.Time) ExchangeStatus {
+	t = t.In(cal.Location())
+	var status ExchangeStatus
+	status.State = MarketClosed
+
+	// Look for the session of today, then for the next one,
+	// up to two weeks ahead to get past long holidays.
+	for i := 0; i < 15; i++ {
+		session, ok := cal.session(t.AddDate(0, 0, i))
+		if !ok {
+			continue
+		}
+		if i == 0 {
+			switch {
+			case t.Before(session.Pre.Start):
+			case t.Before(session.Regular.Start):
+				status.State = MarketPre
+			case t.Before(session.Regular.End):
+				status.State = MarketOpen
+				status.NextClose = session.Regular.End
+				continue
+			case t.Before(session.Post.End):
+				status.State = MarketPost
+				continue
+			default:
+				continue
+			}
+		}
+		status.NextOpen = session.Regular.Start
+		if status.NextClose.IsZero() {
+			status.NextClose = session.Regular.End
+		}
+		return status
+	}
+	return status
+}
+
+// session returns the session of the day of t,
+// or false if the market does not open that day.
+func (cal ExchangeCalendar) session(t time.Time) (CurrentTradingPeriod, bool) {
+	for _, session := range cal.Sessions {
+		if sameDay(session.Regular.Start, t) {
+			if session.Pre.Start.IsZero() {
+				session.Pre = TradingPeriod{Start: session.Regular.Start, End: session.Regular.Start}
+			}
+			if session.Post.Start.IsZero() {
+				session.Post = TradingPeriod{Start: session.Regular.End, End: session.Regular.End}
+			}
+			return session, true
+		}
+	}
+
+	if t.Weekday() == time.Saturday || t.Weekday() == time.Sunday || cal.Open == cal.Close {
+		return CurrentTradingPeriod{}, false
+	}
+	if len(cal.Sessions) > 0 &&
+		t.After(cal.Sessions[0].Regular.Start) &&
+		t.Before(cal.Sessions[len(cal.Sessions)-1].Regular.Start) {
+		return CurrentTradingPeriod{}, false
+	}
+
+	year, month, day := t.Date()
+	at := func(clock time.Duration) time.Time {
+		// Built from the hour and the minute instead of adding the duration to midnight,
+		// so that the hours stay right on days changing to or from daylight saving time.
+		return time.Date(year, month, day, int(clock/time.Hour), int(clock%time.Hour/time.Minute), 0, 0, t.Location())
+	}
+	return CurrentTradingPeriod{
+		Pre:     TradingPeriod{Start: at(cal.PreOpen), End: at(cal.Open)},
+		Regular: TradingPeriod{Start: at(cal.Open), End: at(cal.Close)},
+		Post:    TradingPeriod{Start: at(cal.Close), End: at(cal.PostClose)},
+	}, true
+}
+
+// sameDay tells whether a and b are on the same day, in the timezone of a.
+func sameDay(a time.Time, b time.Time) bool {
+	yearA, monthA, dayA := a.Date()
+	yearB, monthB, dayB := b.In(a.Location()).Date()
+	return yearA == yearB && monthA == monthB && dayA == dayB
+}
+
+// GetExchangeCalendar returns the calendar of the exchange of a ticker,
+// with the sessions of the last five days.
+func (c *Client) GetExchangeCalendar(ctx context.Context, ticker string) (ExchangeCalendar, error) {
+	quote, err := c.getQuote(ctx, ticker, IntervalOneHour, PeriodFiveDays, true)
+	if err != nil {
+		return ExchangeCalendar{}, err
+	}
+	return NewExchangeCalendar(quote.Meta), nil
+}
+
+// MarketStatus returns the state of the market of a ticker now,
+// with its next regular session.
+// Use GetExchangeCalendar to get the status at other times or without a request.
+func (c *Client) MarketStatus(ctx context.Context, ticker string) (ExchangeStatus, error) {
+	calendar, err := c.GetExchangeCalendar(ctx, ticker)
+	if err != nil {
+		return ExchangeStatus{}, err
+	}
+	return calendar.Status(time.Now()), nil
+}
+
+// GetExchangeCalendar returns the calendar of the exchange of a ticker
+// using the default client.
+func GetExchangeCalendar(ticker string) (ExchangeCalendar, error) {
+	return GetExchangeCalendarCtx(context.Background(), ticker)
+}
+
+// GetExchangeCalendarCtx is like GetExchangeCalendar but takes a context
+// to cancel the request or set its deadline.
+func GetExchangeCalendarCtx(ctx context.Context, ticker string) (ExchangeCalendar, error) {
+	return defaultClient.GetExchangeCalendar(ctx, ticker)
+}
+
+// MarketStatus returns the state of the market of a ticker now,
+// with its next regular session, using the default client.
+func MarketStatus(ticker string) (ExchangeStatus, error) {
+	return MarketStatusCtx(context.Background(), ticker)
+}
+
+// MarketStatusCtx is like MarketStatus but takes a context
+// to cancel the request or set its deadline.
+func MarketStatusCtx(ctx context.Context, ticker string) (ExchangeStatus, error) {
+	return defaultClient.MarketStatus(ctx, ticker)
+}
@@ -0,0 +1,75 @@
+package goyfinance
+
+import (
+	"context"
+	"github.com/valyala/fasthttp"
+	"testing"
+	"time"
+)
+
+func TestExchangeCalendarStatus(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip(err)
+	}
+	at := func(day, hour, minute int) time.Time {
+		return time.Date(2024, time.January, day, hour, minute, 0, 0, newYork)
+	}
+	// Known sessions on Tuesday 2 and Thursday 4, so Wednesday 3 is a holiday.
+	calendar := ExchangeCalendar{
+		TimezoneName: "America/New_York",
+		PreOpen:      4 * time.Hour,
+		Open:         9*time.Hour + 30*time.Minute,
+		Close:        16 * time.Hour,
+		PostClose:    20 * time.Hour,
+		Sessions: []CurrentTradingPeriod{
+			{Regular: TradingPeriod{Start: at(2, 9, 30), End: at(2, 16, 0)}, Pre: TradingPeriod{Start: at(2, 4, 0), End: at(2, 9, 30)}, Post: TradingPeriod{Start: at(2, 16, 0), End: at(2, 20, 0)}},
+			{Regular: TradingPeriod{Start: at(4, 9, 30), End: at(4, 16, 0)}, Pre: TradingPeriod{Start: at(4, 4, 0), End: at(4, 9, 30)}, Post: TradingPeriod{Start: at(4, 16, 0), End: at(4, 20, 0)}},
+		},
+	}
+
+	tests := []struct {
+		t         time.Time
+		state     MarketState
+		nextOpen  time.Time
+		nextClose time.Time
+	}{
+		{at(2, 3, 0), MarketClosed, at(2, 9, 30), at(2, 16, 0)},
+		{at(2, 5, 0), MarketPre, at(2, 9, 30), at(2, 16, 0)},
+		{at(2, 10, 0), MarketOpen, at(4, 9, 30), at(2, 16, 0)},
+		{at(2, 17, 0), MarketPost, at(4, 9, 30), at(4, 16, 0)},
+		{at(3, 12, 0), MarketClosed, at(4, 9, 30), at(4, 16, 0)},
+		{at(5, 21, 0), MarketClosed, at(8, 9, 30), at(8, 16, 0)},
+		{at(6, 12, 0), MarketClosed, at(8, 9, 30), at(8, 16, 0)},
+	}
+	for _, test := range tests {
+		status := calendar.Status(test.t)
+		if status.State != test.state || !status.NextOpen.Equal(test.nextOpen) || !status.NextClose.Equal(test.nextClose) {
+			t.Errorf("status at %s is %s, next open %s, next close %s", test.t, status.State, status.NextOpen, status.NextClose)
+		}
+	}
+}
+
+func TestGetExchangeCalendar(t *testing.T) {
+	var gotPrePost, gotInterval string
+	client := newTestClient(t, func(ctx *fasthttp.RequestCtx) {
+		gotPrePost = string(ctx.QueryArgs().Peek("includePrePost"))
+		gotInterval = string(ctx.QueryArgs().Peek("interval"))
+		ctx.SetBodyString(testChartJSON)
+	})
+
+	calendar, err := client.GetExchangeCalendar(context.Background(), "AAPL")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if gotPrePost != "true" || gotInterval != string(IntervalOneHour) {
+		t.Errorf("sent includePrePost=%q and interval=%q", gotPrePost, gotInterval)
+	}
+	if calendar.Exchange != "NMS" || calendar.Location().String() != "America/New_York" {
+		t.Errorf("unexpected calendar %+v", calendar)
+	}
+	if calendar.PreOpen != 4*time.Hour || calendar.Open != 9*time.Hour+30*time.Minute ||
+		calendar.Close != 16*time.Hour || calendar.PostClose != 20*time.Hour {
+		t.Errorf("hours are %s, %s, %s, %s", calendar.PreOpen, calendar.Open, calendar.Close, calendar.PostClose)
+	}
+}
@@ -0,0 +1,179 @@
+package goyfinance
+
+import (
+	"context"
+	"fmt"
+	"github.com/mailru/easyjson"
+	"github.com/valyala/fasthttp"
+	"time"
+)
+
+// OptionType is whether an option is a call or a put.
+type OptionType string
+
+const (
+	OptionCall OptionType = "call"
+	OptionPut  OptionType = "put"
+)
+
+// jsonOptionContract is an option as sent by the options endpoint.
+type jsonOptionContract struct {
+	ContractSymbol    string  `json:"contractSymbol"`
+	Strike            float64 `json:"strike"`
+	Currency          string  `json:"currency"`
+	LastPrice         float64 `json:"lastPrice"`
+	Change            float64 `json:"change"`
+	PercentChange     float64 `json:"percentChange"`
+	Volume            int64   `json:"volume"`
+	OpenInterest      int64   `json:"openInterest"`
+	Bid               float64 `json:"bid"`
+	Ask               float64 `json:"ask"`
+	ContractSize      string  `json:"contractSize"`
+	Expiration        int64   `json:"expiration"`
+	LastTradeDate     int64   `json:"lastTradeDate"`
+	ImpliedVolatility float64 `json:"impliedVolatility"`
+	InTheMoney        bool    `json:"inTheMoney"`
+}
+
+// jsonOptionChainResponse is the response of the options endpoint.
+type jsonOptionChainResponse struct {
+	OptionChain struct {
+		Result []struct {
+			UnderlyingSymbol string       `json:"underlyingSymbol"`
+			ExpirationDates  []int64      `json:"expirationDates"`
+			Strikes          []float64    `json:"strikes"`
+			Quote            JSONSnapshot `json:"quote"`
+			Options          []struct {
+				ExpirationDate int64                `json:"expirationDate"`
+				Calls          []jsonOptionContract `json:"calls"`
+				Puts           []jsonOptionContract `json:"puts"`
+			} `json:"options"`
+		} `json:"result"`
+		Error *JSONError `json:"error"`
+	} `json:"optionChain"`
+}
+
+// OptionContract is a call or a put of an option chain.
+// Yahoo leaves out the fields it has no value for,
+// like the bid and the ask outside of market hours, which are then 0.
+type OptionContract struct {
+	ContractSymbol    string // For example "AAPL240119C00150000"
+	Type              OptionType
+	Strike            float64
+	Expiration        time.Time // Expiration date, at midnight UTC
+	Currency          string
+	ContractSize      string // For example "REGULAR"
+	Bid               float64
+	Ask               float64
+	LastPrice         float64
+	LastTradeDate     time.Time // Zero if the contract never traded
+	Change            float64   // Change of LastPrice since the previous close
+	PercentChange     float64   // Change of LastPrice since the previous close, in percent
+	Volume            int64
+	OpenInterest      int64
+	ImpliedVolatility float64 // Annualized, 0.25 being 25%
+	InTheMoney        bool
+}
+
+// OptionChain is the calls and the puts of a ticker for an expiration date.
+type OptionChain struct {
+	Ticker          string
+	Underlying      Snapshot    // Latest quote of the underlying
+	ExpirationDates []time.Time // Every expiration date of the options of the ticker, at midnight UTC
+	Strikes         []float64   // Every strike of the options of the ticker
+	Expiration      time.Time   // Expiration date of Calls and Puts, at midnight UTC
+	Calls           []OptionContract
+	Puts            []OptionContract
+}
+
+// newOptionContracts returns the contracts of the type optionType from the options endpoint.
+func newOptionContracts(optionType OptionType, jsonContracts []jsonOptionContract) []OptionContract {
+	if len(jsonContracts) == 0 {
+		return nil
+	}
+	contracts := make([]OptionContract, len(jsonContracts))
+	for i, jsonContract := range jsonContracts {
+		contracts[i] = OptionContract{
+			ContractSymbol:    jsonContract.ContractSymbol,
+			Type:              optionType,
+			Strike:            jsonContract.Strike,
+			Expiration:        time.Unix(jsonContract.Expiration, 0).UTC(),
+			Currency:          jsonContract.Currency,
+			ContractSize:      jsonContract.ContractSize,
+			Bid:               jsonContract.Bid,
+			Ask:               jsonContract.Ask,
+			LastPrice:         jsonContract.LastPrice,
+			Change:            jsonContract.Change,
+			PercentChange:     jsonContract.PercentChange,
+			Volume:            jsonContract.Volume,
+			OpenInterest:      jsonContract.OpenInterest,
+			ImpliedVolatility: jsonContract.ImpliedVolatility,
+			InTheMoney:        jsonContract.InTheMoney,
+		}
+		if jsonContract.LastTradeDate != 0 {
+			contracts[i].LastTradeDate = time.Unix(jsonContract.LastTradeDate, 0).UTC()
+		}
+	}
+	return contracts
+}
+
+// GetOptionChain returns the calls and the puts of a ticker expiring on expiration,
+// which must be one of OptionChain.ExpirationDates.
+// A zero expiration returns the options of the nearest expiration date,
+// along with the list of expiration dates to request the others.
+// If an error occurs, the OptionChain struct will be empty.
+func (c *Client) GetOptionChain(ctx context.Context, ticker string, expiration time.Time) (OptionChain, error) {
+	path := "/v7/finance/options/" + ticker
+	if !expiration.IsZero() {
+		path += fmt.Sprintf("?date=%d", expiration.Unix())
+	}
+
+	resp := fasthttp.AcquireResponse()
+	defer fasthttp.ReleaseResponse(resp)
+
+	err := c.fetchWithCrumb(ctx, path, resp)
+	if err != nil {
+		return OptionChain{}, err
+	}
+
+	var jsonResp jsonOptionChainResponse
+	if err := easyjson.Unmarshal(resp.Body(), &jsonResp); err != nil {
+		return OptionChain{}, err
+	}
+	if jsonErr := jsonResp.OptionChain.Error; jsonErr != nil {
+		return OptionChain{}, &APIError{StatusCode: resp.StatusCode(), Code: jsonErr.Code, Description: jsonErr.Description}
+	}
+	if len(jsonResp.OptionChain.Result) == 0 {
+		return OptionChain{}, fmt.Errorf("goyfinance: %s: %w", ticker, ErrNoData)
+	}
+	result := jsonResp.OptionChain.Result[0]
+
+	chain := OptionChain{
+		Ticker:     ticker,
+		Underlying: newSnapshot(ticker, result.Quote),
+		Strikes:    result.Strikes,
+	}
+	for _, expirationDate := range result.ExpirationDates {
+		chain.ExpirationDates = append(chain.ExpirationDates, time.Unix(expirationDate, 0).UTC())
+	}
+	if len(result.Options) > 0 {
+		options := result.Options[0]
+		chain.Expiration = time.Unix(options.ExpirationDate, 0).UTC()
+		chain.Calls = newOptionContracts(OptionCall, options.Calls)
+		chain.Puts = newOptionContracts(OptionPut, options.Puts)
+	}
+	return chain, nil
+}
+
+// GetOptionChain returns the calls and the puts of a ticker expiring on expiration
+// using the default client.
+// If an error occurs, the OptionChain struct will be empty.
+func GetOptionChain(ticker string, expiration time.Time) (OptionChain, error) {
+	return GetOptionChainCtx(context.Background(), ticker, expiration)
+}
+
+// GetOptionChainCtx is like GetOptionChain but takes a context
+// to cancel the request or set its deadline.
+func GetOptionChainCtx(ctx context.Context, ticker string, expiration time.Time) (OptionChain, error) {
+	return defaultClient.GetOptionChain(ctx, ticker, expiration)
+}
@@ -0,0 +1,1140 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package goyfinance
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+	time "time"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson24099d24DecodeGithubComZeteliasGoyfinance(in *jlexer.Lexer, out *jsonOptionContract) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "contractSymbol":
+			out.ContractSymbol = string(in.String())
+		case "strike":
+			out.Strike = float64(in.Float64())
+		case "currency":
+			out.Currency = string(in.String())
+		case "lastPrice":
+			out.LastPrice = float64(in.Float64())
+		case "change":
+			out.Change = float64(in.Float64())
+		case "percentChange":
+			out.PercentChange = float64(in.Float64())
+		case "volume":
+			out.Volume = int64(in.Int64())
+		case "openInterest":
+			out.OpenInterest = int64(in.Int64())
+		case "bid":
+			out.Bid = float64(in.Float64())
+		case "ask":
+			out.Ask = float64(in.Float64())
+		case "contractSize":
+			out.ContractSize = string(in.String())
+		case "expiration":
+			out.Expiration = int64(in.Int64())
+		case "lastTradeDate":
+			out.LastTradeDate = int64(in.Int64())
+		case "impliedVolatility":
+			out.ImpliedVolatility = float64(in.Float64())
+		case "inTheMoney":
+			out.InTheMoney = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson24099d24EncodeGithubComZeteliasGoyfinance(out *jwriter.Writer, in jsonOptionContract) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"contractSymbol\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.ContractSymbol))
+	}
+	{
+		const prefix string = ",\"strike\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Strike))
+	}
+	{
+		const prefix string = ",\"currency\":"
+		out.RawString(prefix)
+		out.String(string(in.Currency))
+	}
+	{
+		const prefix string = ",\"lastPrice\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.LastPrice))
+	}
+	{
+		const prefix string = ",\"change\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Change))
+	}
+	{
+		const prefix string = ",\"percentChange\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.PercentChange))
+	}
+	{
+		const prefix string = ",\"volume\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Volume))
+	}
+	{
+		const prefix string = ",\"openInterest\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.OpenInterest))
+	}
+	{
+		const prefix string = ",\"bid\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Bid))
+	}
+	{
+		const prefix string = ",\"ask\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Ask))
+	}
+	{
+		const prefix string = ",\"contractSize\":"
+		out.RawString(prefix)
+		out.String(string(in.ContractSize))
+	}
+	{
+		const prefix string = ",\"expiration\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Expiration))
+	}
+	{
+		const prefix string = ",\"lastTradeDate\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.LastTradeDate))
+	}
+	{
+		const prefix string = ",\"impliedVolatility\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.ImpliedVolatility))
+	}
+	{
+		const prefix string = ",\"inTheMoney\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.InTheMoney))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v jsonOptionContract) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson24099d24EncodeGithubComZeteliasGoyfinance(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v jsonOptionContract) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson24099d24EncodeGithubComZeteliasGoyfinance(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *jsonOptionContract) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson24099d24DecodeGithubComZeteliasGoyfinance(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *jsonOptionContract) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson24099d24DecodeGithubComZeteliasGoyfinance(l, v)
+}
+func easyjson24099d24DecodeGithubComZeteliasGoyfinance1(in *jlexer.Lexer, out *jsonOptionChainResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "optionChain":
+			easyjson24099d24Decode(in, &out.OptionChain)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson24099d24EncodeGithubComZeteliasGoyfinance1(out *jwriter.Writer, in jsonOptionChainResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"optionChain\":"
+		out.RawString(prefix[1:])
+		easyjson24099d24Encode(out, in.OptionChain)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v jsonOptionChainResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson24099d24EncodeGithubComZeteliasGoyfinance1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v jsonOptionChainResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson24099d24EncodeGithubComZeteliasGoyfinance1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *jsonOptionChainResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson24099d24DecodeGithubComZeteliasGoyfinance1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *jsonOptionChainResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson24099d24DecodeGithubComZeteliasGoyfinance1(l, v)
+}
+func easyjson24099d24Decode(in *jlexer.Lexer, out *struct {
+	Result []struct {
+		UnderlyingSymbol string       `json:"underlyingSymbol"`
+		ExpirationDates  []int64      `json:"expirationDates"`
+		Strikes          []float64    `json:"strikes"`
+		Quote            JSONSnapshot `json:"quote"`
+		Options          []struct {
+			ExpirationDate int64                `json:"expirationDate"`
+			Calls          []jsonOptionContract `json:"calls"`
+			Puts           []jsonOptionContract `json:"puts"`
+		} `json:"options"`
+	} `json:"result"`
+	Error *JSONError `json:"error"`
+}) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "result":
+			if in.IsNull() {
+				in.Skip()
+				out.Result = nil
+			} else {
+				in.Delim('[')
+				if out.Result == nil {
+					if !in.IsDelim(']') {
+						out.Result = make([]struct {
+							UnderlyingSymbol string       `json:"underlyingSymbol"`
+							ExpirationDates  []int64      `json:"expirationDates"`
+							Strikes          []float64    `json:"strikes"`
+							Quote            JSONSnapshot `json:"quote"`
+							Options          []struct {
+								ExpirationDate int64                `json:"expirationDate"`
+								Calls          []jsonOptionContract `json:"calls"`
+								Puts           []jsonOptionContract `json:"puts"`
+							} `json:"options"`
+						}, 0, 0)
+					} else {
+						out.Result = []struct {
+							UnderlyingSymbol string       `json:"underlyingSymbol"`
+							ExpirationDates  []int64      `json:"expirationDates"`
+							Strikes          []float64    `json:"strikes"`
+							Quote            JSONSnapshot `json:"quote"`
+							Options          []struct {
+								ExpirationDate int64                `json:"expirationDate"`
+								Calls          []jsonOptionContract `json:"calls"`
+								Puts           []jsonOptionContract `json:"puts"`
+							} `json:"options"`
+						}{}
+					}
+				} else {
+					out.Result = (out.Result)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 struct {
+						UnderlyingSymbol string       `json:"underlyingSymbol"`
+						ExpirationDates  []int64      `json:"expirationDates"`
+						Strikes          []float64    `json:"strikes"`
+						Quote            JSONSnapshot `json:"quote"`
+						Options          []struct {
+							ExpirationDate int64                `json:"expirationDate"`
+							Calls          []jsonOptionContract `json:"calls"`
+							Puts           []jsonOptionContract `json:"puts"`
+						} `json:"options"`
+					}
+					easyjson24099d24Decode1(in, &v1)
+					out.Result = append(out.Result, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "error":
+			if in.IsNull() {
+				in.Skip()
+				out.Error = nil
+			} else {
+				if out.Error == nil {
+					out.Error = new(JSONError)
+				}
+				(*out.Error).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson24099d24Encode(out *jwriter.Writer, in struct {
+	Result []struct {
+		UnderlyingSymbol string       `json:"underlyingSymbol"`
+		ExpirationDates  []int64      `json:"expirationDates"`
+		Strikes          []float64    `json:"strikes"`
+		Quote            JSONSnapshot `json:"quote"`
+		Options          []struct {
+			ExpirationDate int64                `json:"expirationDate"`
+			Calls          []jsonOptionContract `json:"calls"`
+			Puts           []jsonOptionContract `json:"puts"`
+		} `json:"options"`
+	} `json:"result"`
+	Error *JSONError `json:"error"`
+}) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"result\":"
+		out.RawString(prefix[1:])
+		if in.Result == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v2, v3 := range in.Result {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				easyjson24099d24Encode1(out, v3)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"error\":"
+		out.RawString(prefix)
+		if in.Error == nil {
+			out.RawString("null")
+		} else {
+			(*in.Error).MarshalEasyJSON(out)
+		}
+	}
+	out.RawByte('}')
+}
+func easyjson24099d24Decode1(in *jlexer.Lexer, out *struct {
+	UnderlyingSymbol string       `json:"underlyingSymbol"`
+	ExpirationDates  []int64      `json:"expirationDates"`
+	Strikes          []float64    `json:"strikes"`
+	Quote            JSONSnapshot `json:"quote"`
+	Options          []struct {
+		ExpirationDate int64                `json:"expirationDate"`
+		Calls          []jsonOptionContract `json:"calls"`
+		Puts           []jsonOptionContract `json:"puts"`
+	} `json:"options"`
+}) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "underlyingSymbol":
+			out.UnderlyingSymbol = string(in.String())
+		case "expirationDates":
+			if in.IsNull() {
+				in.Skip()
+				out.ExpirationDates = nil
+			} else {
+				in.Delim('[')
+				if out.ExpirationDates == nil {
+					if !in.IsDelim(']') {
+						out.ExpirationDates = make([]int64, 0, 8)
+					} else {
+						out.ExpirationDates = []int64{}
+					}
+				} else {
+					out.ExpirationDates = (out.ExpirationDates)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v4 int64
+					v4 = int64(in.Int64())
+					out.ExpirationDates = append(out.ExpirationDates, v4)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "strikes":
+			if in.IsNull() {
+				in.Skip()
+				out.Strikes = nil
+			} else {
+				in.Delim('[')
+				if out.Strikes == nil {
+					if !in.IsDelim(']') {
+						out.Strikes = make([]float64, 0, 8)
+					} else {
+						out.Strikes = []float64{}
+					}
+				} else {
+					out.Strikes = (out.Strikes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v5 float64
+					v5 = float64(in.Float64())
+					out.Strikes = append(out.Strikes, v5)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "quote":
+			(out.Quote).UnmarshalEasyJSON(in)
+		case "options":
+			if in.IsNull() {
+				in.Skip()
+				out.Options = nil
+			} else {
+				in.Delim('[')
+				if out.Options == nil {
+					if !in.IsDelim(']') {
+						out.Options = make([]struct {
+							ExpirationDate int64                `json:"expirationDate"`
+							Calls          []jsonOptionContract `json:"calls"`
+							Puts           []jsonOptionContract `json:"puts"`
+						}, 0, 1)
+					} else {
+						out.Options = []struct {
+							ExpirationDate int64                `json:"expirationDate"`
+							Calls          []jsonOptionContract `json:"calls"`
+							Puts           []jsonOptionContract `json:"puts"`
+						}{}
+					}
+				} else {
+					out.Options = (out.Options)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v6 struct {
+						ExpirationDate int64                `json:"expirationDate"`
+						Calls          []jsonOptionContract `json:"calls"`
+						Puts           []jsonOptionContract `json:"puts"`
+					}
+					easyjson24099d24Decode2(in, &v6)
+					out.Options = append(out.Options, v6)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson24099d24Encode1(out *jwriter.Writer, in struct {
+	UnderlyingSymbol string       `json:"underlyingSymbol"`
+	ExpirationDates  []int64      `json:"expirationDates"`
+	Strikes          []float64    `json:"strikes"`
+	Quote            JSONSnapshot `json:"quote"`
+	Options          []struct {
+		ExpirationDate int64                `json:"expirationDate"`
+		Calls          []jsonOptionContract `json:"calls"`
+		Puts           []jsonOptionContract `json:"puts"`
+	} `json:"options"`
+}) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"underlyingSymbol\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.UnderlyingSymbol))
+	}
+	{
+		const prefix string = ",\"expirationDates\":"
+		out.RawString(prefix)
+		if in.ExpirationDates == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v7, v8 := range in.ExpirationDates {
+				if v7 > 0 {
+					out.RawByte(',')
+				}
+				out.Int64(int64(v8))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"strikes\":"
+		out.RawString(prefix)
+		if in.Strikes == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v9, v10 := range in.Strikes {
+				if v9 > 0 {
+					out.RawByte(',')
+				}
+				out.Float64(float64(v10))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"quote\":"
+		out.RawString(prefix)
+		(in.Quote).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"options\":"
+		out.RawString(prefix)
+		if in.Options == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v11, v12 := range in.Options {
+				if v11 > 0 {
+					out.RawByte(',')
+				}
+				easyjson24099d24Encode2(out, v12)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+func easyjson24099d24Decode2(in *jlexer.Lexer, out *struct {
+	ExpirationDate int64                `json:"expirationDate"`
+	Calls          []jsonOptionContract `json:"calls"`
+	Puts           []jsonOptionContract `json:"puts"`
+}) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "expirationDate":
+			out.ExpirationDate = int64(in.Int64())
+		case "calls":
+			if in.IsNull() {
+				in.Skip()
+				out.Calls = nil
+			} else {
+				in.Delim('[')
+				if out.Calls == nil {
+					if !in.IsDelim(']') {
+						out.Calls = make([]jsonOptionContract, 0, 0)
+					} else {
+						out.Calls = []jsonOptionContract{}
+					}
+				} else {
+					out.Calls = (out.Calls)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v13 jsonOptionContract
+					(v13).UnmarshalEasyJSON(in)
+					out.Calls = append(out.Calls, v13)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "puts":
+			if in.IsNull() {
+				in.Skip()
+				out.Puts = nil
+			} else {
+				in.Delim('[')
+				if out.Puts == nil {
+					if !in.IsDelim(']') {
+						out.Puts = make([]jsonOptionContract, 0, 0)
+					} else {
+						out.Puts = []jsonOptionContract{}
+					}
+				} else {
+					out.Puts = (out.Puts)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v14 jsonOptionContract
+					(v14).UnmarshalEasyJSON(in)
+					out.Puts = append(out.Puts, v14)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson24099d24Encode2(out *jwriter.Writer, in struct {
+	ExpirationDate int64                `json:"expirationDate"`
+	Calls          []jsonOptionContract `json:"calls"`
+	Puts           []jsonOptionContract `json:"puts"`
+}) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"expirationDate\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.ExpirationDate))
+	}
+	{
+		const prefix string = ",\"calls\":"
+		out.RawString(prefix)
+		if in.Calls == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v15, v16 := range in.Calls {
+				if v15 > 0 {
+					out.RawByte(',')
+				}
+				(v16).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"puts\":"
+		out.RawString(prefix)
+		if in.Puts == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v17, v18 := range in.Puts {
+				if v17 > 0 {
+					out.RawByte(',')
+				}
+				(v18).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+func easyjson24099d24DecodeGithubComZeteliasGoyfinance2(in *jlexer.Lexer, out *OptionContract) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "ContractSymbol":
+			out.ContractSymbol = string(in.String())
+		case "Type":
+			out.Type = OptionType(in.String())
+		case "Strike":
+			out.Strike = float64(in.Float64())
+		case "Expiration":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Expiration).UnmarshalJSON(data))
+			}
+		case "Currency":
+			out.Currency = string(in.String())
+		case "ContractSize":
+			out.ContractSize = string(in.String())
+		case "Bid":
+			out.Bid = float64(in.Float64())
+		case "Ask":
+			out.Ask = float64(in.Float64())
+		case "LastPrice":
+			out.LastPrice = float64(in.Float64())
+		case "LastTradeDate":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.LastTradeDate).UnmarshalJSON(data))
+			}
+		case "Change":
+			out.Change = float64(in.Float64())
+		case "PercentChange":
+			out.PercentChange = float64(in.Float64())
+		case "Volume":
+			out.Volume = int64(in.Int64())
+		case "OpenInterest":
+			out.OpenInterest = int64(in.Int64())
+		case "ImpliedVolatility":
+			out.ImpliedVolatility = float64(in.Float64())
+		case "InTheMoney":
+			out.InTheMoney = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson24099d24EncodeGithubComZeteliasGoyfinance2(out *jwriter.Writer, in OptionContract) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"ContractSymbol\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.ContractSymbol))
+	}
+	{
+		const prefix string = ",\"Type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"Strike\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Strike))
+	}
+	{
+		const prefix string = ",\"Expiration\":"
+		out.RawString(prefix)
+		out.Raw((in.Expiration).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"Currency\":"
+		out.RawString(prefix)
+		out.String(string(in.Currency))
+	}
+	{
+		const prefix string = ",\"ContractSize\":"
+		out.RawString(prefix)
+		out.String(string(in.ContractSize))
+	}
+	{
+		const prefix string = ",\"Bid\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Bid))
+	}
+	{
+		const prefix string = ",\"Ask\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Ask))
+	}
+	{
+		const prefix string = ",\"LastPrice\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.LastPrice))
+	}
+	{
+		const prefix string = ",\"LastTradeDate\":"
+		out.RawString(prefix)
+		out.Raw((in.LastTradeDate).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"Change\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Change))
+	}
+	{
+		const prefix string = ",\"PercentChange\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.PercentChange))
+	}
+	{
+		const prefix string = ",\"Volume\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Volume))
+	}
+	{
+		const prefix string = ",\"OpenInterest\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.OpenInterest))
+	}
+	{
+		const prefix string = ",\"ImpliedVolatility\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.ImpliedVolatility))
+	}
+	{
+		const prefix string = ",\"InTheMoney\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.InTheMoney))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v OptionContract) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson24099d24EncodeGithubComZeteliasGoyfinance2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v OptionContract) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson24099d24EncodeGithubComZeteliasGoyfinance2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *OptionContract) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson24099d24DecodeGithubComZeteliasGoyfinance2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *OptionContract) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson24099d24DecodeGithubComZeteliasGoyfinance2(l, v)
+}
+func easyjson24099d24DecodeGithubComZeteliasGoyfinance3(in *jlexer.Lexer, out *OptionChain) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "Ticker":
+			out.Ticker = string(in.String())
+		case "Underlying":
+			(out.Underlying).UnmarshalEasyJSON(in)
+		case "ExpirationDates":
+			if in.IsNull() {
+				in.Skip()
+				out.ExpirationDates = nil
+			} else {
+				in.Delim('[')
+				if out.ExpirationDates == nil {
+					if !in.IsDelim(']') {
+						out.ExpirationDates = make([]time.Time, 0, 2)
+					} else {
+						out.ExpirationDates = []time.Time{}
+					}
+				} else {
+					out.ExpirationDates = (out.ExpirationDates)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v19 time.Time
+					if data := in.Raw(); in.Ok() {
+						in.AddError((v19).UnmarshalJSON(data))
+					}
+					out.ExpirationDates = append(out.ExpirationDates, v19)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "Strikes":
+			if in.IsNull() {
+				in.Skip()
+				out.Strikes = nil
+			} else {
+				in.Delim('[')
+				if out.Strikes == nil {
+					if !in.IsDelim(']') {
+						out.Strikes = make([]float64, 0, 8)
+					} else {
+						out.Strikes = []float64{}
+					}
+				} else {
+					out.Strikes = (out.Strikes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v20 float64
+					v20 = float64(in.Float64())
+					out.Strikes = append(out.Strikes, v20)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "Expiration":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Expiration).UnmarshalJSON(data))
+			}
+		case "Calls":
+			if in.IsNull() {
+				in.Skip()
+				out.Calls = nil
+			} else {
+				in.Delim('[')
+				if out.Calls == nil {
+					if !in.IsDelim(']') {
+						out.Calls = make([]OptionContract, 0, 0)
+					} else {
+						out.Calls = []OptionContract{}
+					}
+				} else {
+					out.Calls = (out.Calls)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v21 OptionContract
+					(v21).UnmarshalEasyJSON(in)
+					out.Calls = append(out.Calls, v21)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "Puts":
+			if in.IsNull() {
+				in.Skip()
+				out.Puts = nil
+			} else {
+				in.Delim('[')
+				if out.Puts == nil {
+					if !in.IsDelim(']') {
+						out.Puts = make([]OptionContract, 0, 0)
+					} else {
+						out.Puts = []OptionContract{}
+					}
+				} else {
+					out.Puts = (out.Puts)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v22 OptionContract
+					(v22).UnmarshalEasyJSON(in)
+					out.Puts = append(out.Puts, v22)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson24099d24EncodeGithubComZeteliasGoyfinance3(out *jwriter.Writer, in OptionChain) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Ticker\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Ticker))
+	}
+	{
+		const prefix string = ",\"Underlying\":"
+		out.RawString(prefix)
+		(in.Underlying).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"ExpirationDates\":"
+		out.RawString(prefix)
+		if in.ExpirationDates == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v23, v24 := range in.ExpirationDates {
+				if v23 > 0 {
+					out.RawByte(',')
+				}
+				out.Raw((v24).MarshalJSON())
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"Strikes\":"
+		out.RawString(prefix)
+		if in.Strikes == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v25, v26 := range in.Strikes {
+				if v25 > 0 {
+					out.RawByte(',')
+				}
+				out.Float64(float64(v26))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"Expiration\":"
+		out.RawString(prefix)
+		out.Raw((in.Expiration).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"Calls\":"
+		out.RawString(prefix)
+		if in.Calls == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v27, v28 := range in.Calls {
+				if v27 > 0 {
+					out.RawByte(',')
+				}
+				(v28).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"Puts\":"
+		out.RawString(prefix)
+		if in.Puts == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v29, v30 := range in.Puts {
+				if v29 > 0 {
+					out.RawByte(',')
+				}
+				(v30).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v OptionChain) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson24099d24EncodeGithubComZeteliasGoyfinance3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v OptionChain) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson24099d24EncodeGithubComZeteliasGoyfinance3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *OptionChain) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson24099d24DecodeGithubComZeteliasGoyfinance3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *OptionChain) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson24099d24DecodeGithubComZeteliasGoyfinance3(l, v)
+}
@@ -0,0 +1,69 @@
+package goyfinance
+
+import (
+	"context"
+	"errors"
+	"github.com/valyala/fasthttp"
+	"testing"
+	"time"
+)
+
+// A small option chain for AAPL expiring on 2024-01-19, with a call and a put.
+const testOptionChainJSON = `{"optionChain":{"result":[{"underlyingSymbol":"AAPL","expirationDates":[1705622400,1706227200],"strikes":[150.0,185.0],"hasMiniOptions":false,` +
+	`"quote":{"symbol":"AAPL","exchangeTimezoneName":"America/New_York","marketState":"REGULAR","regularMarketPrice":185.56,"regularMarketTime":1704992400},` +
+	`"options":[{"expirationDate":1705622400,"hasMiniOptions":false,` +
+	`"calls":[{"contractSymbol":"AAPL240119C00150000","strike":150.0,"currency":"USD","lastPrice":35.55,"change":0.5,"percentChange":1.43,"volume":12,"openInterest":8000,"bid":35.4,"ask":35.75,"contractSize":"REGULAR","expiration":1705622400,"lastTradeDate":1704987000,"impliedVolatility":0.5,"inTheMoney":true}],` +
+	`"puts":[{"contractSymbol":"AAPL240119P00185000","strike":185.0,"currency":"USD","lastPrice":1.2,"openInterest":30000,"bid":1.18,"ask":1.21,"contractSize":"REGULAR","expiration":1705622400,"impliedVolatility":0.18,"inTheMoney":false}]}]}],"error":null}}`
+
+func TestGetOptionChain(t *testing.T) {
+	var gotPath, gotDate string
+	client := newTestClient(t, func(ctx *fasthttp.RequestCtx) {
+		switch string(ctx.Path()) {
+		case "/v1/test/getcrumb":
+			ctx.SetBodyString("crumb")
+		case "/v7/finance/options/AAPL":
+			gotPath = string(ctx.Path())
+			gotDate = string(ctx.QueryArgs().Peek("date"))
+			ctx.SetBodyString(testOptionChainJSON)
+		default:
+			ctx.SetBodyString(`{"optionChain":{"result":[],"error":null}}`)
+		}
+	})
+
+	chain, err := client.GetOptionChain(context.Background(), "AAPL", time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if gotPath != "/v7/finance/options/AAPL" || gotDate != "" {
+		t.Errorf("requested %s with date=%q", gotPath, gotDate)
+	}
+	if len(chain.ExpirationDates) != 2 || chain.ExpirationDates[1].Format(time.DateOnly) != "2024-01-26" ||
+		chain.Expiration.Format(time.DateOnly) != "2024-01-19" || len(chain.Strikes) != 2 {
+		t.Errorf("unexpected chain %+v", chain)
+	}
+	if chain.Underlying.Price != 185.56 || chain.Underlying.MarketState != MarketOpen {
+		t.Errorf("unexpected underlying %+v", chain.Underlying)
+	}
+
+	if len(chain.Calls) != 1 || len(chain.Puts) != 1 {
+		t.Fatalf("got %d calls and %d puts", len(chain.Calls), len(chain.Puts))
+	}
+	call, put := chain.Calls[0], chain.Puts[0]
+	if call.Type != OptionCall || call.Strike != 150 || call.Bid != 35.4 || call.Ask != 35.75 || call.LastPrice != 35.55 ||
+		call.Volume != 12 || call.OpenInterest != 8000 || call.ImpliedVolatility != 0.5 || !call.InTheMoney || call.LastTradeDate.IsZero() {
+		t.Errorf("unexpected call %+v", call)
+	}
+	if put.Type != OptionPut || put.Strike != 185 || put.Volume != 0 || put.InTheMoney || !put.LastTradeDate.IsZero() || !put.Expiration.Equal(chain.Expiration) {
+		t.Errorf("unexpected put %+v", put)
+	}
+
+	_, err = client.GetOptionChain(context.Background(), "AAPL", chain.ExpirationDates[1])
+	if err != nil || gotDate != "1706227200" {
+		t.Errorf("sent date=%q, error %v", gotDate, err)
+	}
+
+	_, err = client.GetOptionChain(context.Background(), "NOPE", time.Time{})
+	if !errors.Is(err, ErrNoData) {
+		t.Errorf("expected ErrNoData, got %v", err)
+	}
+}
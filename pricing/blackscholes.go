@@ -0,0 +1,142 @@
+// Package pricing prices European options with the Black-Scholes model,
+// from the option chains of goyfinance.
+package pricing
+
+import (
+	"errors"
+	"fmt"
+	"github.com/Zetelias/goyfinance"
+	"math"
+)
+
+// Errors of ImpliedVolatility and Value, to be used with errors.Is.
+var (
+	ErrNoPrice          = errors.New("pricing: no price to compute from")
+	ErrPriceOutOfBounds = errors.New("pricing: price outside of the no-arbitrage bounds")
+	ErrInvalidInput     = errors.New("pricing: spot and strike must be positive")
+)
+
+// Bounds of the volatility searched by ImpliedVolatility.
+const (
+	minVolatility = 1e-6
+	maxVolatility = 10.0
+)
+
+// Greeks is the theoretical price of an option and its sensitivities.
+type Greeks struct {
+	Price float64
+	Delta float64 // Change of Price for a change of 1 of the spot
+	Gamma float64 // Change of Delta for a change of 1 of the spot
+	Theta float64 // Change of Price per year as time passes, divide by 365 for a day
+	Vega  float64 // Change of Price for a change of 1 (100%) of the volatility, divide by 100 for a point
+	Rho   float64 // Change of Price for a change of 1 (100%) of the rate, divide by 100 for a point
+}
+
+// BlackScholes returns the price and the Greeks of a European option.
+// years is the time to expiration, see YearsToExpiration,
+// rate the continuously compounded risk-free rate and volatility the annualized volatility,
+// both as fractions: 0.05 for 5%.
+// An expired option, or one with no volatility, is worth its discounted intrinsic value.
+// spot and strike must be positive, the Greeks being NaN or infinite otherwise:
+// Value checks them, returning ErrNoPrice or ErrInvalidInput.
+func BlackScholes(optionType goyfinance.OptionType, spot, strike, years, rate, volatility float64) Greeks {
+	years = math.Max(years, 0)
+	discount := math.Exp(-rate * years)
+	if years <= 0 || volatility <= 0 {
+		var greeks Greeks
+		forward := spot - strike*discount
+		if optionType == goyfinance.OptionPut {
+			forward = -forward
+		}
+		if forward > 0 {
+			greeks.Price = forward
+			greeks.Delta = 1
+			greeks.Rho = strike * years * discount
+			if optionType == goyfinance.OptionPut {
+				greeks.Delta = -1
+				greeks.Rho = -greeks.Rho
+			}
+		}
+		return greeks
+	}
+
+	sqrtYears := math.Sqrt(years)
+	d1 := (math.Log(spot/strike) + (rate+volatility*volatility/2)*years) / (volatility * sqrtYears)
+	d2 := d1 - volatility*sqrtYears
+
+	greeks := Greeks{
+		Gamma: normPDF(d1) / (spot * volatility * sqrtYears),
+		Vega:  spot * normPDF(d1) * sqrtYears,
+	}
+	decay := -spot * normPDF(d1) * volatility / (2 * sqrtYears)
+	if optionType == goyfinance.OptionPut {
+		greeks.Price = strike*discount*normCDF(-d2) - spot*normCDF(-d1)
+		greeks.Delta = normCDF(d1) - 1
+		greeks.Theta = decay + rate*strike*discount*normCDF(-d2)
+		greeks.Rho = -strike * years * discount * normCDF(-d2)
+	} else {
+		greeks.Price = spot*normCDF(d1) - strike*discount*normCDF(d2)
+		greeks.Delta = normCDF(d1)
+		greeks.Theta = decay - rate*strike*discount*normCDF(d2)
+		greeks.Rho = strike * years * discount * normCDF(d2)
+	}
+	return greeks
+}
+
+// ImpliedVolatility returns the volatility for which BlackScholes returns price.
+// The error wraps ErrPriceOutOfBounds if no volatility gives that price,
+// for example a price below the intrinsic value,
+// and ErrInvalidInput if spot or strike is not positive.
+func ImpliedVolatility(optionType goyfinance.OptionType, price, spot, strike, years, rate float64) (float64, error) {
+	if price <= 0 {
+		return 0, ErrNoPrice
+	}
+	if spot <= 0 || strike <= 0 {
+		return 0, fmt.Errorf("pricing: spot %g and strike %g: %w", spot, strike, ErrInvalidInput)
+	}
+	if years <= 0 {
+		return 0, fmt.Errorf("pricing: expired option: %w", ErrPriceOutOfBounds)
+	}
+	low := BlackScholes(optionType, spot, strike, years, rate, minVolatility).Price
+	high := BlackScholes(optionType, spot, strike, years, rate, maxVolatility).Price
+	if price < low || price > high {
+		return 0, fmt.Errorf("pricing: price %g is not between %g and %g: %w", price, low, high, ErrPriceOutOfBounds)
+	}
+
+	// Newton's method, falling back to bisection when a step leaves the bracket
+	// [lowVolatility, highVolatility] or vega is too small to move.
+	lowVolatility, highVolatility := minVolatility, maxVolatility
+	volatility := 0.3
+	for i := 0; i < 100; i++ {
+		greeks := BlackScholes(optionType, spot, strike, years, rate, volatility)
+		diff := greeks.Price - price
+		if math.Abs(diff) < 1e-10*math.Max(1, price) {
+			return volatility, nil
+		}
+		if diff > 0 {
+			highVolatility = volatility
+		} else {
+			lowVolatility = volatility
+		}
+
+		next := volatility - diff/greeks.Vega
+		if greeks.Vega < 1e-12 || next <= lowVolatility || next >= highVolatility {
+			next = (lowVolatility + highVolatility) / 2
+		}
+		if math.Abs(next-volatility) < 1e-12 {
+			return next, nil
+		}
+		volatility = next
+	}
+	return volatility, nil
+}
+
+// normCDF is the cumulative distribution function of the standard normal distribution.
+func normCDF(x float64) float64 {
+	return 0.5 * math.Erfc(-x/math.Sqrt2)
+}
+
+// normPDF is the probability density function of the standard normal distribution.
+func normPDF(x float64) float64 {
+	return math.Exp(-x*x/2) / math.Sqrt(2*math.Pi)
+}
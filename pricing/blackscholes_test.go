@@ -0,0 +1,70 @@
+package pricing
+
+import (
+	"errors"
+	"github.com/Zetelias/goyfinance"
+	"math"
+	"testing"
+)
+
+func TestBlackScholes(t *testing.T) {
+	call := BlackScholes(goyfinance.OptionCall, 100, 100, 1, 0.05, 0.2)
+	put := BlackScholes(goyfinance.OptionPut, 100, 100, 1, 0.05, 0.2)
+
+	tests := []struct {
+		name      string
+		got, want float64
+	}{
+		{"call price", call.Price, 10.4506},
+		{"put price", put.Price, 5.5735},
+		{"call delta", call.Delta, 0.6368},
+		{"put delta", put.Delta, -0.3632},
+		{"gamma", call.Gamma, 0.018762},
+		{"vega", call.Vega, 37.524},
+		{"call theta", call.Theta, -6.4140},
+		{"put theta", put.Theta, -1.6579},
+		{"call rho", call.Rho, 53.232},
+		{"put rho", put.Rho, -41.890},
+	}
+	for _, test := range tests {
+		if math.Abs(test.got-test.want) > 1e-3 {
+			t.Errorf("%s: got %.6f, want %.4f", test.name, test.got, test.want)
+		}
+	}
+
+	// Put-call parity: C - P = S - K*exp(-rT).
+	if parity := call.Price - put.Price - (100 - 100*math.Exp(-0.05)); math.Abs(parity) > 1e-9 {
+		t.Errorf("put-call parity off by %g", parity)
+	}
+
+	expired := BlackScholes(goyfinance.OptionPut, 90, 100, 0, 0.05, 0.2)
+	if expired.Price != 10 || expired.Delta != -1 || expired.Gamma != 0 {
+		t.Errorf("unexpected expired put %+v", expired)
+	}
+}
+
+func TestImpliedVolatility(t *testing.T) {
+	for _, volatility := range []float64{0.1, 0.2, 0.8, 3} {
+		for _, strike := range []float64{80, 100, 120} {
+			price := BlackScholes(goyfinance.OptionCall, 100, strike, 0.5, 0.03, volatility).Price
+			got, err := ImpliedVolatility(goyfinance.OptionCall, price, 100, strike, 0.5, 0.03)
+			if err != nil {
+				t.Errorf("strike %g, volatility %g: %v", strike, volatility, err)
+				continue
+			}
+			if math.Abs(got-volatility) > 1e-6 {
+				t.Errorf("strike %g: got volatility %g, want %g", strike, got, volatility)
+			}
+		}
+	}
+
+	// Below the intrinsic value of 20.
+	_, err := ImpliedVolatility(goyfinance.OptionPut, 15, 80, 100, 0.5, 0)
+	if !errors.Is(err, ErrPriceOutOfBounds) {
+		t.Errorf("expected ErrPriceOutOfBounds, got %v", err)
+	}
+	_, err = ImpliedVolatility(goyfinance.OptionPut, 0, 80, 100, 0.5, 0)
+	if !errors.Is(err, ErrNoPrice) {
+		t.Errorf("expected ErrNoPrice, got %v", err)
+	}
+}
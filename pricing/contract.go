@@ -0,0 +1,110 @@
+package pricing
+
+import (
+	"errors"
+	"fmt"
+	"github.com/Zetelias/goyfinance"
+	"time"
+)
+
+// expirationHour is the hour options stop trading on their expiration date,
+// in the timezone of the exchange of the underlying: the close of US markets.
+const expirationHour = 16
+
+// minYahooVolatility is the implied volatility under which Yahoo's value is a placeholder,
+// like the 0.00001 it sends for contracts it could not compute one for.
+const minYahooVolatility = 0.001
+
+// Valuation is the theoretical price and the Greeks of an option contract.
+type Valuation struct {
+	Contract goyfinance.OptionContract
+	Greeks
+	Years      float64 // Time to expiration, see YearsToExpiration
+	Volatility float64 // Volatility used for Greeks
+	// VolatilityFromMid is true if Yahoo had no implied volatility for the contract,
+	// and Volatility was implied from its mid price, or its last price without a bid and an ask.
+	VolatilityFromMid bool
+}
+
+// YearsToExpiration returns the time from now until an option expiring on expiration stops trading,
+// at the close of its expiration date in location, in years of 365 days.
+// expiration is a date at midnight UTC, like OptionContract.Expiration,
+// and location the timezone of the exchange of the underlying.
+func YearsToExpiration(expiration time.Time, now time.Time, location *time.Location) float64 {
+	if location == nil {
+		location = time.UTC
+	}
+	year, month, day := expiration.UTC().Date()
+	expiry := time.Date(year, month, day, expirationHour, 0, 0, 0, location)
+	return expiry.Sub(now).Hours() / 24 / 365
+}
+
+// MidPrice returns the middle of the bid and the ask of a contract,
+// or its last price if it has no bid or no ask.
+// It returns ErrNoPrice if the contract has neither.
+func MidPrice(contract goyfinance.OptionContract) (float64, error) {
+	switch {
+	case contract.Bid > 0 && contract.Ask > 0:
+		return (contract.Bid + contract.Ask) / 2, nil
+	case contract.LastPrice > 0:
+		return contract.LastPrice, nil
+	default:
+		return 0, ErrNoPrice
+	}
+}
+
+// Value returns the theoretical price and the Greeks of a contract at now,
+// with the spot price and the timezone of underlying, and the risk-free rate.
+// It uses the implied volatility from Yahoo,
+// or implies one from the mid price of the contract if Yahoo has none.
+// The error wraps ErrNoPrice if underlying has no price,
+// and ErrInvalidInput if the strike of the contract is not positive.
+func Value(contract goyfinance.OptionContract, underlying goyfinance.Snapshot, now time.Time, rate float64) (Valuation, error) {
+	if underlying.Price <= 0 {
+		return Valuation{}, fmt.Errorf("pricing: %s: price of %s: %w", contract.ContractSymbol, underlying.Ticker, ErrNoPrice)
+	}
+	if contract.Strike <= 0 {
+		return Valuation{}, fmt.Errorf("pricing: %s: strike %g: %w", contract.ContractSymbol, contract.Strike, ErrInvalidInput)
+	}
+
+	valuation := Valuation{
+		Contract:   contract,
+		Years:      YearsToExpiration(contract.Expiration, now, underlying.Time.Location()),
+		Volatility: contract.ImpliedVolatility,
+	}
+
+	if valuation.Volatility < minYahooVolatility {
+		price, err := MidPrice(contract)
+		if err != nil {
+			return Valuation{}, fmt.Errorf("pricing: %s: %w", contract.ContractSymbol, err)
+		}
+		valuation.Volatility, err = ImpliedVolatility(contract.Type, price, underlying.Price, contract.Strike, valuation.Years, rate)
+		if err != nil {
+			return Valuation{}, fmt.Errorf("pricing: %s: %w", contract.ContractSymbol, err)
+		}
+		valuation.VolatilityFromMid = true
+	}
+
+	valuation.Greeks = BlackScholes(contract.Type, underlying.Price, contract.Strike, valuation.Years, rate, valuation.Volatility)
+	return valuation, nil
+}
+
+// ValueChain returns the valuations of the calls then the puts of chain at now,
+// with the risk-free rate.
+// The contracts Value fails for are left out,
+// and their errors joined in the returned error.
+func ValueChain(chain goyfinance.OptionChain, now time.Time, rate float64) ([]Valuation, error) {
+	valuations := make([]Valuation, 0, len(chain.Calls)+len(chain.Puts))
+	var errs []error
+	for _, contracts := range [][]goyfinance.OptionContract{chain.Calls, chain.Puts} {
+		for _, contract := range contracts {
+			valuation, err := Value(contract, chain.Underlying, now, rate)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			valuations = append(valuations, valuation)
+		}
+	}
+	return valuations, errors.Join(errs...)
+}
@@ -0,0 +1,80 @@
+package pricing
+
+import (
+	"errors"
+	"github.com/Zetelias/goyfinance"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestYearsToExpiration(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip(err)
+	}
+	expiration := time.Date(2024, 1, 19, 0, 0, 0, 0, time.UTC)
+	now := time.Date(2024, 1, 18, 16, 0, 0, 0, newYork)
+	if years := YearsToExpiration(expiration, now, newYork); math.Abs(years-1.0/365) > 1e-12 {
+		t.Errorf("got %g years, want one day", years)
+	}
+}
+
+func TestValueChain(t *testing.T) {
+	expiration := time.Date(2024, 1, 19, 0, 0, 0, 0, time.UTC)
+	now := expiration.Add(-30 * 24 * time.Hour)
+	underlying := goyfinance.Snapshot{Ticker: "AAPL", Price: 185, Time: now}
+	years := YearsToExpiration(expiration, now, time.UTC)
+	price := BlackScholes(goyfinance.OptionPut, 185, 180, years, 0.05, 0.25).Price
+
+	chain := goyfinance.OptionChain{
+		Ticker:     "AAPL",
+		Underlying: underlying,
+		Calls: []goyfinance.OptionContract{
+			{ContractSymbol: "CALL", Type: goyfinance.OptionCall, Strike: 150, Expiration: expiration, ImpliedVolatility: 0.5},
+			{ContractSymbol: "NOPRICE", Type: goyfinance.OptionCall, Strike: 300, Expiration: expiration, ImpliedVolatility: 0.00001},
+		},
+		Puts: []goyfinance.OptionContract{
+			{ContractSymbol: "PUT", Type: goyfinance.OptionPut, Strike: 180, Expiration: expiration, Bid: price - 0.05, Ask: price + 0.05},
+		},
+	}
+
+	valuations, err := ValueChain(chain, now, 0.05)
+	if !errors.Is(err, ErrNoPrice) {
+		t.Errorf("expected ErrNoPrice, got %v", err)
+	}
+	if len(valuations) != 2 {
+		t.Fatalf("got %d valuations", len(valuations))
+	}
+
+	call, put := valuations[0], valuations[1]
+	if call.Contract.ContractSymbol != "CALL" || call.Volatility != 0.5 || call.VolatilityFromMid || call.Delta <= 0.9 {
+		t.Errorf("unexpected call valuation %+v", call)
+	}
+	if put.Contract.ContractSymbol != "PUT" || !put.VolatilityFromMid || math.Abs(put.Volatility-0.25) > 1e-6 ||
+		math.Abs(put.Price-price) > 1e-6 || put.Delta >= 0 {
+		t.Errorf("unexpected put valuation %+v", put)
+	}
+}
+
+func TestValueInvalidInput(t *testing.T) {
+	expiration := time.Date(2024, 1, 19, 0, 0, 0, 0, time.UTC)
+	now := expiration.Add(-30 * 24 * time.Hour)
+	contract := goyfinance.OptionContract{ContractSymbol: "CALL", Type: goyfinance.OptionCall, Strike: 150, Expiration: expiration, ImpliedVolatility: 0.5}
+
+	_, err := Value(contract, goyfinance.Snapshot{Ticker: "AAPL", Time: now}, now, 0.05)
+	if !errors.Is(err, ErrNoPrice) {
+		t.Errorf("expected ErrNoPrice without a spot price, got %v", err)
+	}
+
+	contract.Strike = 0
+	_, err = Value(contract, goyfinance.Snapshot{Ticker: "AAPL", Price: 185, Time: now}, now, 0.05)
+	if !errors.Is(err, ErrInvalidInput) {
+		t.Errorf("expected ErrInvalidInput without a strike, got %v", err)
+	}
+
+	_, err = ImpliedVolatility(goyfinance.OptionCall, 10, 0, 100, 0.5, 0.05)
+	if !errors.Is(err, ErrInvalidInput) {
+		t.Errorf("expected ErrInvalidInput without a spot price, got %v", err)
+	}
+}
@@ -0,0 +1,93 @@
+package goyfinance
+
+import (
+	"fmt"
+	"time"
+)
+
+// Range is the time range of the data to get.
+// It is either a Period, which ends now,
+// a NativeRange, which is computed by Yahoo,
+// or a DateRange between two dates.
+type Range interface {
+	// timestamps returns the unix timestamps of the start and the end of the range,
+	// or an error wrapping ErrInvalidRange if the range is not valid.
+	timestamps(now time.Time) (int64, int64, error)
+}
+
+// DateRange is a Range between two dates, for example a backtest window.
+type DateRange struct {
+	Start time.Time
+	End   time.Time
+}
+
+// Validate returns an error wrapping ErrInvalidRange
+// if the range is not set or does not start before it ends.
+func (r DateRange) Validate() error {
+	if r.Start.IsZero() || r.End.IsZero() {
+		return fmt.Errorf("goyfinance: date range needs a start and an end: %w", ErrInvalidRange)
+	}
+	if !r.Start.Before(r.End) {
+		return fmt.Errorf("goyfinance: date range starts at %s, which is not before its end %s: %w", r.Start, r.End, ErrInvalidRange)
+	}
+	return nil
+}
+
+func (r DateRange) timestamps(time.Time) (int64, int64, error) {
+	if err := r.Validate(); err != nil {
+		return 0, 0, err
+	}
+	return r.Start.Unix(), r.End.Unix(), nil
+}
+
+// maxPeriodStart is the start of PeriodMax,
+// before the first trade date of any ticker.
+var maxPeriodStart = time.Date(1900, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// timestamps returns the unix timestamps
+// of `period` days/mo/years before now and of now.
+func (period Period) timestamps(now time.Time) (int64, int64, error) {
+	var pastPeriod time.Time
+
+	switch period {
+	case PeriodOneDay:
+		pastPeriod = now.AddDate(0, 0, -1)
+	case PeriodFiveDays:
+		pastPeriod = now.AddDate(0, 0, -5)
+	case PeriodOneMonth:
+		pastPeriod = now.AddDate(0, -1, 0)
+	case PeriodThreeMonth:
+		pastPeriod = now.AddDate(0, -3, 0)
+	case PeriodSixMonth:
+		pastPeriod = now.AddDate(0, -6, 0)
+	case PeriodOneYear:
+		pastPeriod = now.AddDate(-1, 0, 0)
+	case PeriodTwoYears:
+		pastPeriod = now.AddDate(-2, 0, 0)
+	case PeriodFiveYear:
+		pastPeriod = now.AddDate(-5, 0, 0)
+	case PeriodTenYears:
+		pastPeriod = now.AddDate(-10, 0, 0)
+	case PeriodYtd:
+		pastPeriod = time.Date(now.Year(), 1, 1, 0, 0, 0, 0, now.Location())
+	case PeriodMax:
+		pastPeriod = maxPeriodStart
+	default:
+		return 0, 0, fmt.Errorf("goyfinance: unsupported period %q: %w", string(period), ErrInvalidRange)
+	}
+
+	// Convert the time.Time objects to unix timestamps
+	return pastPeriod.Unix(), now.Unix(), nil
+}
+
+// NativeRange is a Range sent to Yahoo as the range parameter,
+// for example NativeRange(PeriodMax) for the full history of a ticker.
+// Yahoo computes the start and the end of the range,
+// instead of the local clock as for a Period.
+// The range Yahoo served is in Quote.ServedRange.
+// GetQuoteCSVString sends the equivalent Period instead.
+type NativeRange Period
+
+func (r NativeRange) timestamps(now time.Time) (int64, int64, error) {
+	return Period(r).timestamps(now)
+}
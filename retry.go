@@ -0,0 +1,141 @@
+package goyfinance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/valyala/fasthttp"
+	"io"
+	"math/rand"
+	"net"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+// RetryPolicy tells a Client when and how to retry a failed request.
+// Only GET and HEAD requests are retried, after a transport error
+// such as a timeout or a reset connection, or after a 429 or 5xx response.
+// The delay between attempts doubles after each attempt, with jitter,
+// unless the response has a longer Retry-After header.
+// The client gives up when Retry-After asks to wait longer than MaxDelay.
+type RetryPolicy struct {
+	MaxAttempts int           // Total number of attempts, 1 or less means no retry
+	BaseDelay   time.Duration // Delay before the first retry
+	MaxDelay    time.Duration // Maximum delay between two attempts, 0 means no maximum
+}
+
+// DefaultRetryPolicy is the retry policy of a Client
+// when no retry policy is given.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 3,
+	BaseDelay:   500 * time.Millisecond,
+	MaxDelay:    10 * time.Second,
+}
+
+// WithRetryPolicy sets the retry policy of the client.
+// It defaults to DefaultRetryPolicy,
+// use RetryPolicy{MaxAttempts: 1} to disable retries.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(c *Client) {
+		c.retryPolicy = policy
+	}
+}
+
+// RetryError is returned when a request was retried and still failed,
+// Attempts being the number of requests sent.
+// Err is the error that ended the retries: the error of the last attempt,
+// or the error of the context if it was done while waiting to retry.
+// A request that failed on its first attempt returns its error unwrapped.
+type RetryError struct {
+	Attempts int
+	Err      error
+}
+
+func (e *RetryError) Error() string {
+	return fmt.Sprintf("goyfinance: giving up after %d attempts: %v", e.Attempts, e.Err)
+}
+
+func (e *RetryError) Unwrap() error {
+	return e.Err
+}
+
+// newRetryError wraps err in a *RetryError if more than one attempt was sent,
+// and returns it unchanged otherwise.
+func newRetryError(attempts int, err error) error {
+	if attempts <= 1 {
+		return err
+	}
+	return &RetryError{Attempts: attempts, Err: err}
+}
+
+// backoff returns the delay before the retry following attempt,
+// which is the first attempt if attempt is 1.
+// It is between half and all of BaseDelay * 2^(attempt-1).
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	delay := p.BaseDelay
+	for i := 1; i < attempt && (p.MaxDelay <= 0 || delay < p.MaxDelay); i++ {
+		delay *= 2
+	}
+	if p.MaxDelay > 0 && delay > p.MaxDelay {
+		delay = p.MaxDelay
+	}
+	if delay <= 0 {
+		return 0
+	}
+	return delay/2 + time.Duration(rand.Int63n(int64(delay/2)+1))
+}
+
+// isRetryableStatus tells whether a response with statusCode is worth retrying.
+func isRetryableStatus(statusCode int) bool {
+	return statusCode == fasthttp.StatusTooManyRequests || statusCode >= 500
+}
+
+// isRetryableError tells whether req is worth sending again after err.
+// Only idempotent requests are retried, and only after transport errors
+// that may not happen again, never after the context is done.
+func isRetryableError(req *fasthttp.Request, err error) bool {
+	if !req.Header.IsGet() && !req.Header.IsHead() {
+		return false
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	if errors.Is(err, fasthttp.ErrTimeout) ||
+		errors.Is(err, fasthttp.ErrDialTimeout) ||
+		errors.Is(err, fasthttp.ErrConnectionClosed) ||
+		errors.Is(err, fasthttp.ErrNoFreeConns) ||
+		errors.Is(err, io.EOF) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.ECONNREFUSED) {
+		return true
+	}
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return dnsErr.IsTimeout || dnsErr.IsTemporary
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+// retryAfter returns the delay asked by the Retry-After header of resp,
+// given in seconds or as an HTTP date, or 0 if there is none.
+func retryAfter(resp *fasthttp.Response) time.Duration {
+	value := string(resp.Header.Peek(fasthttp.HeaderRetryAfter))
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+	if date, err := fasthttp.ParseHTTPDate([]byte(value)); err == nil {
+		if delay := time.Until(date); delay > 0 {
+			return delay
+		}
+	}
+	return 0
+}
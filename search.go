@@ -0,0 +1,136 @@
+package goyfinance
+
+import (
+	"context"
+	"github.com/mailru/easyjson"
+	"github.com/valyala/fasthttp"
+	"net/url"
+	"time"
+)
+
+// jsonSearchResponse is the response of the search endpoint.
+type jsonSearchResponse struct {
+	Quotes []struct {
+		Symbol         string  `json:"symbol"`
+		ShortName      string  `json:"shortname"`
+		LongName       string  `json:"longname"`
+		QuoteType      string  `json:"quoteType"`
+		TypeDisp       string  `json:"typeDisp"`
+		Exchange       string  `json:"exchange"`
+		ExchDisp       string  `json:"exchDisp"`
+		Sector         string  `json:"sector"`
+		Industry       string  `json:"industry"`
+		Score          float64 `json:"score"`
+		IsYahooFinance bool    `json:"isYahooFinance"`
+	} `json:"quotes"`
+	News []struct {
+		UUID                string   `json:"uuid"`
+		Title               string   `json:"title"`
+		Publisher           string   `json:"publisher"`
+		Link                string   `json:"link"`
+		ProviderPublishTime int64    `json:"providerPublishTime"`
+		Type                string   `json:"type"`
+		RelatedTickers      []string `json:"relatedTickers"`
+	} `json:"news"`
+}
+
+// SearchQuote is a symbol matching a search.
+type SearchQuote struct {
+	Symbol       string // Ticker to use with GetQuote, for example "AAPL"
+	ShortName    string
+	LongName     string
+	QuoteType    string // For example "EQUITY", "ETF" or "CRYPTOCURRENCY"
+	TypeDisplay  string // QuoteType as displayed by Yahoo, for example "Equity"
+	Exchange     string // For example "NMS"
+	ExchangeName string // Exchange as displayed by Yahoo, for example "NASDAQ"
+	Sector       string // Empty for anything else than an equity
+	Industry     string // Empty for anything else than an equity
+	Score        float64
+}
+
+// SearchNews is a news article matching a search.
+type SearchNews struct {
+	UUID           string
+	Title          string
+	Publisher      string
+	Link           string
+	PublishTime    time.Time // UTC
+	Type           string    // For example "STORY" or "VIDEO"
+	RelatedTickers []string
+}
+
+// SearchResult is the symbols and the news matching a search,
+// sorted from the best match as ranked by Yahoo.
+type SearchResult struct {
+	Query  string
+	Quotes []SearchQuote
+	News   []SearchNews
+}
+
+// Search returns the symbols and the news matching query,
+// which can be a company name like "apple", to find the ticker to use with GetQuote.
+// Symbols Yahoo only knows from other sources than Yahoo Finance are left out.
+// If nothing matches, Quotes and News are empty and the error is nil.
+// If an error occurs, the SearchResult struct will be empty.
+func (c *Client) Search(ctx context.Context, query string) (SearchResult, error) {
+	resp := fasthttp.AcquireResponse()
+	defer fasthttp.ReleaseResponse(resp)
+
+	err := c.fetch(ctx, "/v1/finance/search?q="+url.QueryEscape(query), resp)
+	if err != nil {
+		return SearchResult{}, err
+	}
+
+	var jsonResp jsonSearchResponse
+	if err := easyjson.Unmarshal(resp.Body(), &jsonResp); err != nil {
+		return SearchResult{}, err
+	}
+
+	result := SearchResult{
+		Query:  query,
+		Quotes: make([]SearchQuote, 0, len(jsonResp.Quotes)),
+		News:   make([]SearchNews, 0, len(jsonResp.News)),
+	}
+	for _, quote := range jsonResp.Quotes {
+		if quote.Symbol == "" || !quote.IsYahooFinance {
+			continue
+		}
+		result.Quotes = append(result.Quotes, SearchQuote{
+			Symbol:       quote.Symbol,
+			ShortName:    quote.ShortName,
+			LongName:     quote.LongName,
+			QuoteType:    quote.QuoteType,
+			TypeDisplay:  quote.TypeDisp,
+			Exchange:     quote.Exchange,
+			ExchangeName: quote.ExchDisp,
+			Sector:       quote.Sector,
+			Industry:     quote.Industry,
+			Score:        quote.Score,
+		})
+	}
+	for _, news := range jsonResp.News {
+		result.News = append(result.News, SearchNews{
+			UUID:           news.UUID,
+			Title:          news.Title,
+			Publisher:      news.Publisher,
+			Link:           news.Link,
+			PublishTime:    time.Unix(news.ProviderPublishTime, 0).UTC(),
+			Type:           news.Type,
+			RelatedTickers: news.RelatedTickers,
+		})
+	}
+	return result, nil
+}
+
+// Search returns the symbols and the news matching query
+// using the default client.
+// If an error occurs, the SearchResult struct will be empty.
+func Search(query string) (SearchResult, error) {
+	return SearchCtx(context.Background(), query)
+}
+
+// SearchCtx is like Search but takes a context
+// to cancel the request or set its deadline.
+func SearchCtx(ctx context.Context, query string) (SearchResult, error) {
+	return defaultClient.Search(ctx, query)
+}
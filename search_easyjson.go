@@ -0,0 +1,899 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package goyfinance
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjsonD4176298DecodeGithubComZeteliasGoyfinance(in *jlexer.Lexer, out *jsonSearchResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "quotes":
+			if in.IsNull() {
+				in.Skip()
+				out.Quotes = nil
+			} else {
+				in.Delim('[')
+				if out.Quotes == nil {
+					if !in.IsDelim(']') {
+						out.Quotes = make([]struct {
+							Symbol         string  `json:"symbol"`
+							ShortName      string  `json:"shortname"`
+							LongName       string  `json:"longname"`
+							QuoteType      string  `json:"quoteType"`
+							TypeDisp       string  `json:"typeDisp"`
+							Exchange       string  `json:"exchange"`
+							ExchDisp       string  `json:"exchDisp"`
+							Sector         string  `json:"sector"`
+							Industry       string  `json:"industry"`
+							Score          float64 `json:"score"`
+							IsYahooFinance bool    `json:"isYahooFinance"`
+						}, 0, 0)
+					} else {
+						out.Quotes = []struct {
+							Symbol         string  `json:"symbol"`
+							ShortName      string  `json:"shortname"`
+							LongName       string  `json:"longname"`
+							QuoteType      string  `json:"quoteType"`
+							TypeDisp       string  `json:"typeDisp"`
+							Exchange       string  `json:"exchange"`
+							ExchDisp       string  `json:"exchDisp"`
+							Sector         string  `json:"sector"`
+							Industry       string  `json:"industry"`
+							Score          float64 `json:"score"`
+							IsYahooFinance bool    `json:"isYahooFinance"`
+						}{}
+					}
+				} else {
+					out.Quotes = (out.Quotes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 struct {
+						Symbol         string  `json:"symbol"`
+						ShortName      string  `json:"shortname"`
+						LongName       string  `json:"longname"`
+						QuoteType      string  `json:"quoteType"`
+						TypeDisp       string  `json:"typeDisp"`
+						Exchange       string  `json:"exchange"`
+						ExchDisp       string  `json:"exchDisp"`
+						Sector         string  `json:"sector"`
+						Industry       string  `json:"industry"`
+						Score          float64 `json:"score"`
+						IsYahooFinance bool    `json:"isYahooFinance"`
+					}
+					easyjsonD4176298Decode(in, &v1)
+					out.Quotes = append(out.Quotes, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "news":
+			if in.IsNull() {
+				in.Skip()
+				out.News = nil
+			} else {
+				in.Delim('[')
+				if out.News == nil {
+					if !in.IsDelim(']') {
+						out.News = make([]struct {
+							UUID                string   `json:"uuid"`
+							Title               string   `json:"title"`
+							Publisher           string   `json:"publisher"`
+							Link                string   `json:"link"`
+							ProviderPublishTime int64    `json:"providerPublishTime"`
+							Type                string   `json:"type"`
+							RelatedTickers      []string `json:"relatedTickers"`
+						}, 0, 0)
+					} else {
+						out.News = []struct {
+							UUID                string   `json:"uuid"`
+							Title               string   `json:"title"`
+							Publisher           string   `json:"publisher"`
+							Link                string   `json:"link"`
+							ProviderPublishTime int64    `json:"providerPublishTime"`
+							Type                string   `json:"type"`
+							RelatedTickers      []string `json:"relatedTickers"`
+						}{}
+					}
+				} else {
+					out.News = (out.News)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v2 struct {
+						UUID                string   `json:"uuid"`
+						Title               string   `json:"title"`
+						Publisher           string   `json:"publisher"`
+						Link                string   `json:"link"`
+						ProviderPublishTime int64    `json:"providerPublishTime"`
+						Type                string   `json:"type"`
+						RelatedTickers      []string `json:"relatedTickers"`
+					}
+					easyjsonD4176298Decode1(in, &v2)
+					out.News = append(out.News, v2)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD4176298EncodeGithubComZeteliasGoyfinance(out *jwriter.Writer, in jsonSearchResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"quotes\":"
+		out.RawString(prefix[1:])
+		if in.Quotes == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v3, v4 := range in.Quotes {
+				if v3 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonD4176298Encode(out, v4)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"news\":"
+		out.RawString(prefix)
+		if in.News == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v5, v6 := range in.News {
+				if v5 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonD4176298Encode1(out, v6)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v jsonSearchResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD4176298EncodeGithubComZeteliasGoyfinance(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v jsonSearchResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD4176298EncodeGithubComZeteliasGoyfinance(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *jsonSearchResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD4176298DecodeGithubComZeteliasGoyfinance(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *jsonSearchResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD4176298DecodeGithubComZeteliasGoyfinance(l, v)
+}
+func easyjsonD4176298Decode1(in *jlexer.Lexer, out *struct {
+	UUID                string   `json:"uuid"`
+	Title               string   `json:"title"`
+	Publisher           string   `json:"publisher"`
+	Link                string   `json:"link"`
+	ProviderPublishTime int64    `json:"providerPublishTime"`
+	Type                string   `json:"type"`
+	RelatedTickers      []string `json:"relatedTickers"`
+}) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "uuid":
+			out.UUID = string(in.String())
+		case "title":
+			out.Title = string(in.String())
+		case "publisher":
+			out.Publisher = string(in.String())
+		case "link":
+			out.Link = string(in.String())
+		case "providerPublishTime":
+			out.ProviderPublishTime = int64(in.Int64())
+		case "type":
+			out.Type = string(in.String())
+		case "relatedTickers":
+			if in.IsNull() {
+				in.Skip()
+				out.RelatedTickers = nil
+			} else {
+				in.Delim('[')
+				if out.RelatedTickers == nil {
+					if !in.IsDelim(']') {
+						out.RelatedTickers = make([]string, 0, 4)
+					} else {
+						out.RelatedTickers = []string{}
+					}
+				} else {
+					out.RelatedTickers = (out.RelatedTickers)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v7 string
+					v7 = string(in.String())
+					out.RelatedTickers = append(out.RelatedTickers, v7)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD4176298Encode1(out *jwriter.Writer, in struct {
+	UUID                string   `json:"uuid"`
+	Title               string   `json:"title"`
+	Publisher           string   `json:"publisher"`
+	Link                string   `json:"link"`
+	ProviderPublishTime int64    `json:"providerPublishTime"`
+	Type                string   `json:"type"`
+	RelatedTickers      []string `json:"relatedTickers"`
+}) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"uuid\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.UUID))
+	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"publisher\":"
+		out.RawString(prefix)
+		out.String(string(in.Publisher))
+	}
+	{
+		const prefix string = ",\"link\":"
+		out.RawString(prefix)
+		out.String(string(in.Link))
+	}
+	{
+		const prefix string = ",\"providerPublishTime\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.ProviderPublishTime))
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"relatedTickers\":"
+		out.RawString(prefix)
+		if in.RelatedTickers == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v8, v9 := range in.RelatedTickers {
+				if v8 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v9))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+func easyjsonD4176298Decode(in *jlexer.Lexer, out *struct {
+	Symbol         string  `json:"symbol"`
+	ShortName      string  `json:"shortname"`
+	LongName       string  `json:"longname"`
+	QuoteType      string  `json:"quoteType"`
+	TypeDisp       string  `json:"typeDisp"`
+	Exchange       string  `json:"exchange"`
+	ExchDisp       string  `json:"exchDisp"`
+	Sector         string  `json:"sector"`
+	Industry       string  `json:"industry"`
+	Score          float64 `json:"score"`
+	IsYahooFinance bool    `json:"isYahooFinance"`
+}) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "symbol":
+			out.Symbol = string(in.String())
+		case "shortname":
+			out.ShortName = string(in.String())
+		case "longname":
+			out.LongName = string(in.String())
+		case "quoteType":
+			out.QuoteType = string(in.String())
+		case "typeDisp":
+			out.TypeDisp = string(in.String())
+		case "exchange":
+			out.Exchange = string(in.String())
+		case "exchDisp":
+			out.ExchDisp = string(in.String())
+		case "sector":
+			out.Sector = string(in.String())
+		case "industry":
+			out.Industry = string(in.String())
+		case "score":
+			out.Score = float64(in.Float64())
+		case "isYahooFinance":
+			out.IsYahooFinance = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD4176298Encode(out *jwriter.Writer, in struct {
+	Symbol         string  `json:"symbol"`
+	ShortName      string  `json:"shortname"`
+	LongName       string  `json:"longname"`
+	QuoteType      string  `json:"quoteType"`
+	TypeDisp       string  `json:"typeDisp"`
+	Exchange       string  `json:"exchange"`
+	ExchDisp       string  `json:"exchDisp"`
+	Sector         string  `json:"sector"`
+	Industry       string  `json:"industry"`
+	Score          float64 `json:"score"`
+	IsYahooFinance bool    `json:"isYahooFinance"`
+}) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"symbol\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Symbol))
+	}
+	{
+		const prefix string = ",\"shortname\":"
+		out.RawString(prefix)
+		out.String(string(in.ShortName))
+	}
+	{
+		const prefix string = ",\"longname\":"
+		out.RawString(prefix)
+		out.String(string(in.LongName))
+	}
+	{
+		const prefix string = ",\"quoteType\":"
+		out.RawString(prefix)
+		out.String(string(in.QuoteType))
+	}
+	{
+		const prefix string = ",\"typeDisp\":"
+		out.RawString(prefix)
+		out.String(string(in.TypeDisp))
+	}
+	{
+		const prefix string = ",\"exchange\":"
+		out.RawString(prefix)
+		out.String(string(in.Exchange))
+	}
+	{
+		const prefix string = ",\"exchDisp\":"
+		out.RawString(prefix)
+		out.String(string(in.ExchDisp))
+	}
+	{
+		const prefix string = ",\"sector\":"
+		out.RawString(prefix)
+		out.String(string(in.Sector))
+	}
+	{
+		const prefix string = ",\"industry\":"
+		out.RawString(prefix)
+		out.String(string(in.Industry))
+	}
+	{
+		const prefix string = ",\"score\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Score))
+	}
+	{
+		const prefix string = ",\"isYahooFinance\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsYahooFinance))
+	}
+	out.RawByte('}')
+}
+func easyjsonD4176298DecodeGithubComZeteliasGoyfinance1(in *jlexer.Lexer, out *SearchResult) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "Query":
+			out.Query = string(in.String())
+		case "Quotes":
+			if in.IsNull() {
+				in.Skip()
+				out.Quotes = nil
+			} else {
+				in.Delim('[')
+				if out.Quotes == nil {
+					if !in.IsDelim(']') {
+						out.Quotes = make([]SearchQuote, 0, 0)
+					} else {
+						out.Quotes = []SearchQuote{}
+					}
+				} else {
+					out.Quotes = (out.Quotes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v10 SearchQuote
+					(v10).UnmarshalEasyJSON(in)
+					out.Quotes = append(out.Quotes, v10)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "News":
+			if in.IsNull() {
+				in.Skip()
+				out.News = nil
+			} else {
+				in.Delim('[')
+				if out.News == nil {
+					if !in.IsDelim(']') {
+						out.News = make([]SearchNews, 0, 0)
+					} else {
+						out.News = []SearchNews{}
+					}
+				} else {
+					out.News = (out.News)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v11 SearchNews
+					(v11).UnmarshalEasyJSON(in)
+					out.News = append(out.News, v11)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD4176298EncodeGithubComZeteliasGoyfinance1(out *jwriter.Writer, in SearchResult) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Query\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Query))
+	}
+	{
+		const prefix string = ",\"Quotes\":"
+		out.RawString(prefix)
+		if in.Quotes == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v12, v13 := range in.Quotes {
+				if v12 > 0 {
+					out.RawByte(',')
+				}
+				(v13).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"News\":"
+		out.RawString(prefix)
+		if in.News == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v14, v15 := range in.News {
+				if v14 > 0 {
+					out.RawByte(',')
+				}
+				(v15).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SearchResult) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD4176298EncodeGithubComZeteliasGoyfinance1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SearchResult) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD4176298EncodeGithubComZeteliasGoyfinance1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SearchResult) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD4176298DecodeGithubComZeteliasGoyfinance1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SearchResult) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD4176298DecodeGithubComZeteliasGoyfinance1(l, v)
+}
+func easyjsonD4176298DecodeGithubComZeteliasGoyfinance2(in *jlexer.Lexer, out *SearchQuote) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "Symbol":
+			out.Symbol = string(in.String())
+		case "ShortName":
+			out.ShortName = string(in.String())
+		case "LongName":
+			out.LongName = string(in.String())
+		case "QuoteType":
+			out.QuoteType = string(in.String())
+		case "TypeDisplay":
+			out.TypeDisplay = string(in.String())
+		case "Exchange":
+			out.Exchange = string(in.String())
+		case "ExchangeName":
+			out.ExchangeName = string(in.String())
+		case "Sector":
+			out.Sector = string(in.String())
+		case "Industry":
+			out.Industry = string(in.String())
+		case "Score":
+			out.Score = float64(in.Float64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD4176298EncodeGithubComZeteliasGoyfinance2(out *jwriter.Writer, in SearchQuote) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Symbol\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Symbol))
+	}
+	{
+		const prefix string = ",\"ShortName\":"
+		out.RawString(prefix)
+		out.String(string(in.ShortName))
+	}
+	{
+		const prefix string = ",\"LongName\":"
+		out.RawString(prefix)
+		out.String(string(in.LongName))
+	}
+	{
+		const prefix string = ",\"QuoteType\":"
+		out.RawString(prefix)
+		out.String(string(in.QuoteType))
+	}
+	{
+		const prefix string = ",\"TypeDisplay\":"
+		out.RawString(prefix)
+		out.String(string(in.TypeDisplay))
+	}
+	{
+		const prefix string = ",\"Exchange\":"
+		out.RawString(prefix)
+		out.String(string(in.Exchange))
+	}
+	{
+		const prefix string = ",\"ExchangeName\":"
+		out.RawString(prefix)
+		out.String(string(in.ExchangeName))
+	}
+	{
+		const prefix string = ",\"Sector\":"
+		out.RawString(prefix)
+		out.String(string(in.Sector))
+	}
+	{
+		const prefix string = ",\"Industry\":"
+		out.RawString(prefix)
+		out.String(string(in.Industry))
+	}
+	{
+		const prefix string = ",\"Score\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Score))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SearchQuote) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD4176298EncodeGithubComZeteliasGoyfinance2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SearchQuote) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD4176298EncodeGithubComZeteliasGoyfinance2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SearchQuote) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD4176298DecodeGithubComZeteliasGoyfinance2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SearchQuote) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD4176298DecodeGithubComZeteliasGoyfinance2(l, v)
+}
+func easyjsonD4176298DecodeGithubComZeteliasGoyfinance3(in *jlexer.Lexer, out *SearchNews) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "UUID":
+			out.UUID = string(in.String())
+		case "Title":
+			out.Title = string(in.String())
+		case "Publisher":
+			out.Publisher = string(in.String())
+		case "Link":
+			out.Link = string(in.String())
+		case "PublishTime":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.PublishTime).UnmarshalJSON(data))
+			}
+		case "Type":
+			out.Type = string(in.String())
+		case "RelatedTickers":
+			if in.IsNull() {
+				in.Skip()
+				out.RelatedTickers = nil
+			} else {
+				in.Delim('[')
+				if out.RelatedTickers == nil {
+					if !in.IsDelim(']') {
+						out.RelatedTickers = make([]string, 0, 4)
+					} else {
+						out.RelatedTickers = []string{}
+					}
+				} else {
+					out.RelatedTickers = (out.RelatedTickers)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v16 string
+					v16 = string(in.String())
+					out.RelatedTickers = append(out.RelatedTickers, v16)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD4176298EncodeGithubComZeteliasGoyfinance3(out *jwriter.Writer, in SearchNews) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"UUID\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.UUID))
+	}
+	{
+		const prefix string = ",\"Title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"Publisher\":"
+		out.RawString(prefix)
+		out.String(string(in.Publisher))
+	}
+	{
+		const prefix string = ",\"Link\":"
+		out.RawString(prefix)
+		out.String(string(in.Link))
+	}
+	{
+		const prefix string = ",\"PublishTime\":"
+		out.RawString(prefix)
+		out.Raw((in.PublishTime).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"Type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"RelatedTickers\":"
+		out.RawString(prefix)
+		if in.RelatedTickers == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v17, v18 := range in.RelatedTickers {
+				if v17 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v18))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SearchNews) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD4176298EncodeGithubComZeteliasGoyfinance3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SearchNews) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD4176298EncodeGithubComZeteliasGoyfinance3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SearchNews) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD4176298DecodeGithubComZeteliasGoyfinance3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SearchNews) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD4176298DecodeGithubComZeteliasGoyfinance3(l, v)
+}
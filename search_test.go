@@ -0,0 +1,52 @@
+package goyfinance
+
+import (
+	"context"
+	"github.com/valyala/fasthttp"
+	"testing"
+)
+
+func TestSearch(t *testing.T) {
+	var gotQuery string
+	client := newTestClient(t, func(ctx *fasthttp.RequestCtx) {
+		gotQuery = string(ctx.QueryArgs().Peek("q"))
+		if gotQuery != "apple inc" {
+			ctx.SetBodyString(`{"explains":[],"count":0,"quotes":[],"news":[]}`)
+			return
+		}
+		ctx.SetBodyString(`{"explains":[],"count":3,"quotes":[` +
+			`{"exchange":"NMS","shortname":"Apple Inc.","quoteType":"EQUITY","symbol":"AAPL","index":"quotes","score":2000000.0,"typeDisp":"Equity","longname":"Apple Inc.","exchDisp":"NASDAQ","sector":"Technology","industry":"Consumer Electronics","isYahooFinance":true},` +
+			`{"index":"8f3a","name":"Apple Hospitality","permalink":"apple-hospitality","isYahooFinance":false},` +
+			`{"exchange":"NEO","shortname":"APPLE CDR (CAD HEDGED)","quoteType":"EQUITY","symbol":"AAPL.NE","index":"quotes","score":20043.0,"typeDisp":"Equity","exchDisp":"NEO","isYahooFinance":true}],` +
+			`"news":[{"uuid":"7e1a","title":"Apple earnings","publisher":"Reuters","link":"https://finance.yahoo.com/news/apple","providerPublishTime":1704488400,"type":"STORY","relatedTickers":["AAPL"]}]}`)
+	})
+
+	result, err := client.Search(context.Background(), "apple inc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if gotQuery != "apple inc" || result.Query != "apple inc" {
+		t.Errorf("sent q=%q", gotQuery)
+	}
+	if len(result.Quotes) != 2 {
+		t.Fatalf("got %d quotes", len(result.Quotes))
+	}
+	apple := result.Quotes[0]
+	if apple.Symbol != "AAPL" || apple.LongName != "Apple Inc." || apple.QuoteType != "EQUITY" || apple.Exchange != "NMS" ||
+		apple.ExchangeName != "NASDAQ" || apple.Score != 2000000 || apple.Industry != "Consumer Electronics" {
+		t.Errorf("unexpected quote %+v", apple)
+	}
+	if result.Quotes[1].Symbol != "AAPL.NE" || result.Quotes[1].LongName != "" {
+		t.Errorf("unexpected quote %+v", result.Quotes[1])
+	}
+	if len(result.News) != 1 || result.News[0].Publisher != "Reuters" || result.News[0].PublishTime.Unix() != 1704488400 ||
+		len(result.News[0].RelatedTickers) != 1 {
+		t.Errorf("unexpected news %+v", result.News)
+	}
+
+	// No match is not an error.
+	result, err = client.Search(context.Background(), "zzzz")
+	if err != nil || result.Query != "zzzz" || result.Quotes == nil || len(result.Quotes) != 0 || result.News == nil || len(result.News) != 0 {
+		t.Errorf("unexpected result %+v, error %v", result, err)
+	}
+}
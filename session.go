@@ -0,0 +1,167 @@
+package goyfinance
+
+import (
+	"github.com/mailru/easyjson/jlexer"
+	"github.com/mailru/easyjson/jwriter"
+	"time"
+)
+
+// Session is the trading session a bar belongs to.
+type Session string
+
+const (
+	SessionPre     Session = "pre"     // Pre-market, before the regular session
+	SessionRegular Session = "regular" // Regular trading hours
+	SessionPost    Session = "post"    // Post-market, after the regular session
+)
+
+// JSONTradingPeriods is the trading sessions of the days of a chart, grouped by day.
+// Yahoo sends them as an array of regular sessions,
+// or as an object with the pre, regular and post sessions
+// when extended hours are included, so it is decoded by hand.
+type JSONTradingPeriods struct {
+	Pre     [][]JSONTradingPeriod `json:"pre"`
+	Regular [][]JSONTradingPeriod `json:"regular"`
+	Post    [][]JSONTradingPeriod `json:"post"`
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *JSONTradingPeriods) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	if in.IsNull() {
+		in.Skip()
+		return
+	}
+	if !in.IsDelim('{') {
+		v.Regular = decodeTradingPeriodDays(in)
+		return
+	}
+
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "pre":
+			v.Pre = decodeTradingPeriodDays(in)
+		case "regular":
+			v.Regular = decodeTradingPeriodDays(in)
+		case "post":
+			v.Post = decodeTradingPeriodDays(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v JSONTradingPeriods) MarshalEasyJSON(out *jwriter.Writer) {
+	out.RawString(`{"pre":`)
+	encodeTradingPeriodDays(out, v.Pre)
+	out.RawString(`,"regular":`)
+	encodeTradingPeriodDays(out, v.Regular)
+	out.RawString(`,"post":`)
+	encodeTradingPeriodDays(out, v.Post)
+	out.RawByte('}')
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *JSONTradingPeriods) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	v.UnmarshalEasyJSON(&r)
+	return r.Error()
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v JSONTradingPeriods) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	v.MarshalEasyJSON(&w)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// decodeTradingPeriodDays decodes an array of days of trading periods.
+func decodeTradingPeriodDays(in *jlexer.Lexer) [][]JSONTradingPeriod {
+	if in.IsNull() {
+		in.Skip()
+		return nil
+	}
+	var days [][]JSONTradingPeriod
+	in.Delim('[')
+	for !in.IsDelim(']') {
+		var day []JSONTradingPeriod
+		in.Delim('[')
+		for !in.IsDelim(']') {
+			var tradingPeriod JSONTradingPeriod
+			tradingPeriod.UnmarshalEasyJSON(in)
+			day = append(day, tradingPeriod)
+			in.WantComma()
+		}
+		in.Delim(']')
+		days = append(days, day)
+		in.WantComma()
+	}
+	in.Delim(']')
+	return days
+}
+
+// encodeTradingPeriodDays encodes an array of days of trading periods.
+func encodeTradingPeriodDays(out *jwriter.Writer, days [][]JSONTradingPeriod) {
+	if days == nil {
+		out.RawString("null")
+		return
+	}
+	out.RawByte('[')
+	for i, day := range days {
+		if i > 0 {
+			out.RawByte(',')
+		}
+		out.RawByte('[')
+		for j, tradingPeriod := range day {
+			if j > 0 {
+				out.RawByte(',')
+			}
+			tradingPeriod.MarshalEasyJSON(out)
+		}
+		out.RawByte(']')
+	}
+	out.RawByte(']')
+}
+
+// isIntraday tells whether bars of interval are shorter than a day.
+func isIntraday(interval Interval) bool {
+	return intervalLimits[interval].maxLookback > 0
+}
+
+// sessionOf returns the session of a bar starting at t.
+// It uses the regular session of the same day if there is one,
+// and the time of day of the current regular session otherwise.
+func sessionOf(t time.Time, meta QuoteMeta) Session {
+	regular := meta.CurrentTradingPeriod.Regular
+	year, month, day := t.Date()
+	for _, tradingPeriod := range meta.TradingPeriods {
+		if y, m, d := tradingPeriod.Start.Date(); y == year && m == month && d == day {
+			regular = tradingPeriod
+			break
+		}
+	}
+	if regular.Start.IsZero() {
+		return SessionRegular
+	}
+
+	clock := timeOfDay(t)
+	switch {
+	case clock < timeOfDay(regular.Start):
+		return SessionPre
+	case clock >= timeOfDay(regular.End):
+		return SessionPost
+	default:
+		return SessionRegular
+	}
+}
+
+// timeOfDay returns the duration since the start of the day of t.
+func timeOfDay(t time.Time) time.Duration {
+	hour, minute, second := t.Clock()
+	return time.Duration(hour)*time.Hour + time.Duration(minute)*time.Minute + time.Duration(second)*time.Second
+}
@@ -0,0 +1,246 @@
+package goyfinance
+
+import (
+	"context"
+	"fmt"
+	"github.com/mailru/easyjson"
+	"github.com/valyala/fasthttp"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// maxSnapshotSymbols is the number of symbols sent in a single request
+// to the quote endpoint, which keeps URLs short enough for Yahoo.
+// Longer lists of tickers are split into several concurrent requests.
+const maxSnapshotSymbols = 100
+
+// JSONSnapshot is a quote as sent by the v7 quote endpoint.
+// Fields missing from the response, like the bid and the ask
+// outside of market hours, are left as the zero value.
+type JSONSnapshot struct {
+	Symbol                     string  `json:"symbol"`
+	ShortName                  string  `json:"shortName"`
+	LongName                   string  `json:"longName"`
+	QuoteType                  string  `json:"quoteType"`
+	Currency                   string  `json:"currency"`
+	Exchange                   string  `json:"exchange"`
+	FullExchangeName           string  `json:"fullExchangeName"`
+	ExchangeTimezoneName       string  `json:"exchangeTimezoneName"`
+	ExchangeTimezoneShortName  string  `json:"exchangeTimezoneShortName"`
+	GmtOffSetMilliseconds      int64   `json:"gmtOffSetMilliseconds"`
+	MarketState                string  `json:"marketState"`
+	RegularMarketTime          int64   `json:"regularMarketTime"`
+	RegularMarketPrice         float64 `json:"regularMarketPrice"`
+	RegularMarketChange        float64 `json:"regularMarketChange"`
+	RegularMarketChangePercent float64 `json:"regularMarketChangePercent"`
+	RegularMarketPreviousClose float64 `json:"regularMarketPreviousClose"`
+	RegularMarketOpen          float64 `json:"regularMarketOpen"`
+	RegularMarketDayLow        float64 `json:"regularMarketDayLow"`
+	RegularMarketDayHigh       float64 `json:"regularMarketDayHigh"`
+	RegularMarketVolume        int64   `json:"regularMarketVolume"`
+	AverageDailyVolume3Month   int64   `json:"averageDailyVolume3Month"`
+	Bid                        float64 `json:"bid"`
+	Ask                        float64 `json:"ask"`
+	BidSize                    int64   `json:"bidSize"`
+	AskSize                    int64   `json:"askSize"`
+	MarketCap                  int64   `json:"marketCap"`
+	FiftyTwoWeekLow            float64 `json:"fiftyTwoWeekLow"`
+	FiftyTwoWeekHigh           float64 `json:"fiftyTwoWeekHigh"`
+	PreMarketPrice             float64 `json:"preMarketPrice"`
+	PreMarketTime              int64   `json:"preMarketTime"`
+	PostMarketPrice            float64 `json:"postMarketPrice"`
+	PostMarketTime             int64   `json:"postMarketTime"`
+}
+
+// jsonSnapshotResponse is the response of the v7 quote endpoint.
+type jsonSnapshotResponse struct {
+	QuoteResponse struct {
+		Result []JSONSnapshot `json:"result"`
+		Error  *JSONError     `json:"error"`
+	} `json:"quoteResponse"`
+}
+
+// Snapshot is the latest quote of a ticker:
+// last price, day range, bid and ask, volume and market capitalization.
+// Times are in the timezone of the exchange,
+// and are zero when Yahoo does not send them.
+type Snapshot struct {
+	Ticker      string // As given to GetSnapshot
+	Symbol      string // As sent by Yahoo, for example "AAPL"
+	ShortName   string
+	LongName    string
+	QuoteType   string // For example "EQUITY", "ETF" or "CURRENCY"
+	Currency    string
+	Exchange    string // For example "NMS"
+	MarketState MarketState
+
+	Time          time.Time // Time of Price
+	Price         float64   // Last price of the regular session
+	Change        float64   // Change of Price since PreviousClose
+	ChangePercent float64   // Change of Price since PreviousClose, in percent
+	PreviousClose float64
+	Open          float64
+	DayLow        float64
+	DayHigh       float64
+	Volume        int64
+	AverageVolume int64 // Average daily volume over the last three months
+
+	Bid     float64
+	Ask     float64
+	BidSize int64
+	AskSize int64
+
+	MarketCap        int64
+	FiftyTwoWeekLow  float64
+	FiftyTwoWeekHigh float64
+
+	PreMarketTime   time.Time
+	PreMarketPrice  float64
+	PostMarketTime  time.Time
+	PostMarketPrice float64
+}
+
+// newSnapshot returns the Snapshot of ticker from the quote sent by Yahoo.
+func newSnapshot(ticker string, jsonSnapshot JSONSnapshot) Snapshot {
+	location := exchangeLocation(jsonSnapshot.ExchangeTimezoneName, jsonSnapshot.ExchangeTimezoneShortName, int(jsonSnapshot.GmtOffSetMilliseconds/1000))
+	unixTime := func(timestamp int64) time.Time {
+		if timestamp == 0 {
+			return time.Time{}
+		}
+		return time.Unix(timestamp, 0).In(location)
+	}
+
+	return Snapshot{
+		Ticker:      ticker,
+		Symbol:      jsonSnapshot.Symbol,
+		ShortName:   jsonSnapshot.ShortName,
+		LongName:    jsonSnapshot.LongName,
+		QuoteType:   jsonSnapshot.QuoteType,
+		Currency:    jsonSnapshot.Currency,
+		Exchange:    jsonSnapshot.Exchange,
+		MarketState: marketStateOf(jsonSnapshot.MarketState),
+
+		Time:          unixTime(jsonSnapshot.RegularMarketTime),
+		Price:         jsonSnapshot.RegularMarketPrice,
+		Change:        jsonSnapshot.RegularMarketChange,
+		ChangePercent: jsonSnapshot.RegularMarketChangePercent,
+		PreviousClose: jsonSnapshot.RegularMarketPreviousClose,
+		Open:          jsonSnapshot.RegularMarketOpen,
+		DayLow:        jsonSnapshot.RegularMarketDayLow,
+		DayHigh:       jsonSnapshot.RegularMarketDayHigh,
+		Volume:        jsonSnapshot.RegularMarketVolume,
+		AverageVolume: jsonSnapshot.AverageDailyVolume3Month,
+
+		Bid:     jsonSnapshot.Bid,
+		Ask:     jsonSnapshot.Ask,
+		BidSize: jsonSnapshot.BidSize,
+		AskSize: jsonSnapshot.AskSize,
+
+		MarketCap:        jsonSnapshot.MarketCap,
+		FiftyTwoWeekLow:  jsonSnapshot.FiftyTwoWeekLow,
+		FiftyTwoWeekHigh: jsonSnapshot.FiftyTwoWeekHigh,
+
+		PreMarketTime:   unixTime(jsonSnapshot.PreMarketTime),
+		PreMarketPrice:  jsonSnapshot.PreMarketPrice,
+		PostMarketTime:  unixTime(jsonSnapshot.PostMarketTime),
+		PostMarketPrice: jsonSnapshot.PostMarketPrice,
+	}
+}
+
+// marketStateOf returns the MarketState of a market state sent by Yahoo.
+// The states before the pre-market and after the post-market,
+// "PREPRE" and "POSTPOST", are closed.
+func marketStateOf(state string) MarketState {
+	switch state {
+	case "PRE":
+		return MarketPre
+	case "REGULAR":
+		return MarketOpen
+	case "POST":
+		return MarketPost
+	default:
+		return MarketClosed
+	}
+}
+
+// GetSnapshot returns the latest quote of every ticker from the v7 quote endpoint,
+// with up to 100 tickers per request, which is much lighter than GetQuote
+// when only the last price is needed.
+// The order of the slice is the same as the order of the tickers.
+// If a ticker fails, its Snapshot is empty and the error is a *BatchError,
+// wrapping ErrTickerNotFound for a ticker Yahoo did not send a quote for.
+func (c *Client) GetSnapshot(ctx context.Context, tickers ...string) ([]Snapshot, error) {
+	res := make([]Snapshot, len(tickers))
+	errs := make([]error, len(tickers))
+
+	chunks := (len(tickers) + maxSnapshotSymbols - 1) / maxSnapshotSymbols
+	forEach(chunks, c.maxConcurrency, func(i int) {
+		start := i * maxSnapshotSymbols
+		end := min(start+maxSnapshotSymbols, len(tickers))
+		c.getSnapshots(ctx, tickers[start:end], res[start:end], errs[start:end])
+	})
+
+	return res, newBatchError(tickers, errs)
+}
+
+// getSnapshots fills res with the snapshots of tickers from a single request,
+// and errs with the errors of the tickers that failed.
+func (c *Client) getSnapshots(ctx context.Context, tickers []string, res []Snapshot, errs []error) {
+	setErr := func(err error) {
+		for i := range errs {
+			errs[i] = err
+		}
+	}
+
+	symbols := make([]string, len(tickers))
+	for i, ticker := range tickers {
+		symbols[i] = url.QueryEscape(ticker)
+	}
+
+	resp := fasthttp.AcquireResponse()
+	defer fasthttp.ReleaseResponse(resp)
+
+	err := c.fetchWithCrumb(ctx, "/v7/finance/quote?symbols="+strings.Join(symbols, ","), resp)
+	if err != nil {
+		setErr(err)
+		return
+	}
+
+	var jsonResp jsonSnapshotResponse
+	if err := easyjson.Unmarshal(resp.Body(), &jsonResp); err != nil {
+		setErr(err)
+		return
+	}
+	if jsonErr := jsonResp.QuoteResponse.Error; jsonErr != nil {
+		setErr(&APIError{StatusCode: resp.StatusCode(), Code: jsonErr.Code, Description: jsonErr.Description})
+		return
+	}
+
+	bySymbol := make(map[string]JSONSnapshot, len(jsonResp.QuoteResponse.Result))
+	for _, jsonSnapshot := range jsonResp.QuoteResponse.Result {
+		bySymbol[strings.ToUpper(jsonSnapshot.Symbol)] = jsonSnapshot
+	}
+	for i, ticker := range tickers {
+		jsonSnapshot, ok := bySymbol[strings.ToUpper(ticker)]
+		if !ok {
+			errs[i] = fmt.Errorf("goyfinance: no quote for %s: %w", ticker, ErrTickerNotFound)
+			continue
+		}
+		res[i] = newSnapshot(ticker, jsonSnapshot)
+	}
+}
+
+// GetSnapshot returns the latest quote of every ticker
+// using the default client.
+// The order of the slice is the same as the order of the tickers.
+// If a ticker fails, its Snapshot is empty and the error is a *BatchError.
+func GetSnapshot(tickers ...string) ([]Snapshot, error) {
+	return GetSnapshotCtx(context.Background(), tickers...)
+}
+
+// GetSnapshotCtx is like GetSnapshot but takes a context
+// to cancel the requests or set their deadline.
+func GetSnapshotCtx(ctx context.Context, tickers ...string) ([]Snapshot, error) {
+	return defaultClient.GetSnapshot(ctx, tickers...)
+}
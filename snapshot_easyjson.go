@@ -0,0 +1,735 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package goyfinance
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjsonD3e3e4f0DecodeGithubComZeteliasGoyfinance(in *jlexer.Lexer, out *jsonSnapshotResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "quoteResponse":
+			easyjsonD3e3e4f0Decode(in, &out.QuoteResponse)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD3e3e4f0EncodeGithubComZeteliasGoyfinance(out *jwriter.Writer, in jsonSnapshotResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"quoteResponse\":"
+		out.RawString(prefix[1:])
+		easyjsonD3e3e4f0Encode(out, in.QuoteResponse)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v jsonSnapshotResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD3e3e4f0EncodeGithubComZeteliasGoyfinance(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v jsonSnapshotResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD3e3e4f0EncodeGithubComZeteliasGoyfinance(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *jsonSnapshotResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD3e3e4f0DecodeGithubComZeteliasGoyfinance(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *jsonSnapshotResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD3e3e4f0DecodeGithubComZeteliasGoyfinance(l, v)
+}
+func easyjsonD3e3e4f0Decode(in *jlexer.Lexer, out *struct {
+	Result []JSONSnapshot `json:"result"`
+	Error  *JSONError     `json:"error"`
+}) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "result":
+			if in.IsNull() {
+				in.Skip()
+				out.Result = nil
+			} else {
+				in.Delim('[')
+				if out.Result == nil {
+					if !in.IsDelim(']') {
+						out.Result = make([]JSONSnapshot, 0, 0)
+					} else {
+						out.Result = []JSONSnapshot{}
+					}
+				} else {
+					out.Result = (out.Result)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 JSONSnapshot
+					(v1).UnmarshalEasyJSON(in)
+					out.Result = append(out.Result, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "error":
+			if in.IsNull() {
+				in.Skip()
+				out.Error = nil
+			} else {
+				if out.Error == nil {
+					out.Error = new(JSONError)
+				}
+				(*out.Error).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD3e3e4f0Encode(out *jwriter.Writer, in struct {
+	Result []JSONSnapshot `json:"result"`
+	Error  *JSONError     `json:"error"`
+}) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"result\":"
+		out.RawString(prefix[1:])
+		if in.Result == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v2, v3 := range in.Result {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				(v3).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"error\":"
+		out.RawString(prefix)
+		if in.Error == nil {
+			out.RawString("null")
+		} else {
+			(*in.Error).MarshalEasyJSON(out)
+		}
+	}
+	out.RawByte('}')
+}
+func easyjsonD3e3e4f0DecodeGithubComZeteliasGoyfinance1(in *jlexer.Lexer, out *Snapshot) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "Ticker":
+			out.Ticker = string(in.String())
+		case "Symbol":
+			out.Symbol = string(in.String())
+		case "ShortName":
+			out.ShortName = string(in.String())
+		case "LongName":
+			out.LongName = string(in.String())
+		case "QuoteType":
+			out.QuoteType = string(in.String())
+		case "Currency":
+			out.Currency = string(in.String())
+		case "Exchange":
+			out.Exchange = string(in.String())
+		case "MarketState":
+			out.MarketState = MarketState(in.String())
+		case "Time":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Time).UnmarshalJSON(data))
+			}
+		case "Price":
+			out.Price = float64(in.Float64())
+		case "Change":
+			out.Change = float64(in.Float64())
+		case "ChangePercent":
+			out.ChangePercent = float64(in.Float64())
+		case "PreviousClose":
+			out.PreviousClose = float64(in.Float64())
+		case "Open":
+			out.Open = float64(in.Float64())
+		case "DayLow":
+			out.DayLow = float64(in.Float64())
+		case "DayHigh":
+			out.DayHigh = float64(in.Float64())
+		case "Volume":
+			out.Volume = int64(in.Int64())
+		case "AverageVolume":
+			out.AverageVolume = int64(in.Int64())
+		case "Bid":
+			out.Bid = float64(in.Float64())
+		case "Ask":
+			out.Ask = float64(in.Float64())
+		case "BidSize":
+			out.BidSize = int64(in.Int64())
+		case "AskSize":
+			out.AskSize = int64(in.Int64())
+		case "MarketCap":
+			out.MarketCap = int64(in.Int64())
+		case "FiftyTwoWeekLow":
+			out.FiftyTwoWeekLow = float64(in.Float64())
+		case "FiftyTwoWeekHigh":
+			out.FiftyTwoWeekHigh = float64(in.Float64())
+		case "PreMarketTime":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.PreMarketTime).UnmarshalJSON(data))
+			}
+		case "PreMarketPrice":
+			out.PreMarketPrice = float64(in.Float64())
+		case "PostMarketTime":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.PostMarketTime).UnmarshalJSON(data))
+			}
+		case "PostMarketPrice":
+			out.PostMarketPrice = float64(in.Float64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD3e3e4f0EncodeGithubComZeteliasGoyfinance1(out *jwriter.Writer, in Snapshot) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Ticker\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Ticker))
+	}
+	{
+		const prefix string = ",\"Symbol\":"
+		out.RawString(prefix)
+		out.String(string(in.Symbol))
+	}
+	{
+		const prefix string = ",\"ShortName\":"
+		out.RawString(prefix)
+		out.String(string(in.ShortName))
+	}
+	{
+		const prefix string = ",\"LongName\":"
+		out.RawString(prefix)
+		out.String(string(in.LongName))
+	}
+	{
+		const prefix string = ",\"QuoteType\":"
+		out.RawString(prefix)
+		out.String(string(in.QuoteType))
+	}
+	{
+		const prefix string = ",\"Currency\":"
+		out.RawString(prefix)
+		out.String(string(in.Currency))
+	}
+	{
+		const prefix string = ",\"Exchange\":"
+		out.RawString(prefix)
+		out.String(string(in.Exchange))
+	}
+	{
+		const prefix string = ",\"MarketState\":"
+		out.RawString(prefix)
+		out.String(string(in.MarketState))
+	}
+	{
+		const prefix string = ",\"Time\":"
+		out.RawString(prefix)
+		out.Raw((in.Time).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"Price\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Price))
+	}
+	{
+		const prefix string = ",\"Change\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Change))
+	}
+	{
+		const prefix string = ",\"ChangePercent\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.ChangePercent))
+	}
+	{
+		const prefix string = ",\"PreviousClose\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.PreviousClose))
+	}
+	{
+		const prefix string = ",\"Open\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Open))
+	}
+	{
+		const prefix string = ",\"DayLow\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.DayLow))
+	}
+	{
+		const prefix string = ",\"DayHigh\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.DayHigh))
+	}
+	{
+		const prefix string = ",\"Volume\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Volume))
+	}
+	{
+		const prefix string = ",\"AverageVolume\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.AverageVolume))
+	}
+	{
+		const prefix string = ",\"Bid\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Bid))
+	}
+	{
+		const prefix string = ",\"Ask\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Ask))
+	}
+	{
+		const prefix string = ",\"BidSize\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.BidSize))
+	}
+	{
+		const prefix string = ",\"AskSize\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.AskSize))
+	}
+	{
+		const prefix string = ",\"MarketCap\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.MarketCap))
+	}
+	{
+		const prefix string = ",\"FiftyTwoWeekLow\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.FiftyTwoWeekLow))
+	}
+	{
+		const prefix string = ",\"FiftyTwoWeekHigh\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.FiftyTwoWeekHigh))
+	}
+	{
+		const prefix string = ",\"PreMarketTime\":"
+		out.RawString(prefix)
+		out.Raw((in.PreMarketTime).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"PreMarketPrice\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.PreMarketPrice))
+	}
+	{
+		const prefix string = ",\"PostMarketTime\":"
+		out.RawString(prefix)
+		out.Raw((in.PostMarketTime).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"PostMarketPrice\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.PostMarketPrice))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Snapshot) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD3e3e4f0EncodeGithubComZeteliasGoyfinance1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Snapshot) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD3e3e4f0EncodeGithubComZeteliasGoyfinance1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Snapshot) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD3e3e4f0DecodeGithubComZeteliasGoyfinance1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Snapshot) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD3e3e4f0DecodeGithubComZeteliasGoyfinance1(l, v)
+}
+func easyjsonD3e3e4f0DecodeGithubComZeteliasGoyfinance2(in *jlexer.Lexer, out *JSONSnapshot) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "symbol":
+			out.Symbol = string(in.String())
+		case "shortName":
+			out.ShortName = string(in.String())
+		case "longName":
+			out.LongName = string(in.String())
+		case "quoteType":
+			out.QuoteType = string(in.String())
+		case "currency":
+			out.Currency = string(in.String())
+		case "exchange":
+			out.Exchange = string(in.String())
+		case "fullExchangeName":
+			out.FullExchangeName = string(in.String())
+		case "exchangeTimezoneName":
+			out.ExchangeTimezoneName = string(in.String())
+		case "exchangeTimezoneShortName":
+			out.ExchangeTimezoneShortName = string(in.String())
+		case "gmtOffSetMilliseconds":
+			out.GmtOffSetMilliseconds = int64(in.Int64())
+		case "marketState":
+			out.MarketState = string(in.String())
+		case "regularMarketTime":
+			out.RegularMarketTime = int64(in.Int64())
+		case "regularMarketPrice":
+			out.RegularMarketPrice = float64(in.Float64())
+		case "regularMarketChange":
+			out.RegularMarketChange = float64(in.Float64())
+		case "regularMarketChangePercent":
+			out.RegularMarketChangePercent = float64(in.Float64())
+		case "regularMarketPreviousClose":
+			out.RegularMarketPreviousClose = float64(in.Float64())
+		case "regularMarketOpen":
+			out.RegularMarketOpen = float64(in.Float64())
+		case "regularMarketDayLow":
+			out.RegularMarketDayLow = float64(in.Float64())
+		case "regularMarketDayHigh":
+			out.RegularMarketDayHigh = float64(in.Float64())
+		case "regularMarketVolume":
+			out.RegularMarketVolume = int64(in.Int64())
+		case "averageDailyVolume3Month":
+			out.AverageDailyVolume3Month = int64(in.Int64())
+		case "bid":
+			out.Bid = float64(in.Float64())
+		case "ask":
+			out.Ask = float64(in.Float64())
+		case "bidSize":
+			out.BidSize = int64(in.Int64())
+		case "askSize":
+			out.AskSize = int64(in.Int64())
+		case "marketCap":
+			out.MarketCap = int64(in.Int64())
+		case "fiftyTwoWeekLow":
+			out.FiftyTwoWeekLow = float64(in.Float64())
+		case "fiftyTwoWeekHigh":
+			out.FiftyTwoWeekHigh = float64(in.Float64())
+		case "preMarketPrice":
+			out.PreMarketPrice = float64(in.Float64())
+		case "preMarketTime":
+			out.PreMarketTime = int64(in.Int64())
+		case "postMarketPrice":
+			out.PostMarketPrice = float64(in.Float64())
+		case "postMarketTime":
+			out.PostMarketTime = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD3e3e4f0EncodeGithubComZeteliasGoyfinance2(out *jwriter.Writer, in JSONSnapshot) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"symbol\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Symbol))
+	}
+	{
+		const prefix string = ",\"shortName\":"
+		out.RawString(prefix)
+		out.String(string(in.ShortName))
+	}
+	{
+		const prefix string = ",\"longName\":"
+		out.RawString(prefix)
+		out.String(string(in.LongName))
+	}
+	{
+		const prefix string = ",\"quoteType\":"
+		out.RawString(prefix)
+		out.String(string(in.QuoteType))
+	}
+	{
+		const prefix string = ",\"currency\":"
+		out.RawString(prefix)
+		out.String(string(in.Currency))
+	}
+	{
+		const prefix string = ",\"exchange\":"
+		out.RawString(prefix)
+		out.String(string(in.Exchange))
+	}
+	{
+		const prefix string = ",\"fullExchangeName\":"
+		out.RawString(prefix)
+		out.String(string(in.FullExchangeName))
+	}
+	{
+		const prefix string = ",\"exchangeTimezoneName\":"
+		out.RawString(prefix)
+		out.String(string(in.ExchangeTimezoneName))
+	}
+	{
+		const prefix string = ",\"exchangeTimezoneShortName\":"
+		out.RawString(prefix)
+		out.String(string(in.ExchangeTimezoneShortName))
+	}
+	{
+		const prefix string = ",\"gmtOffSetMilliseconds\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.GmtOffSetMilliseconds))
+	}
+	{
+		const prefix string = ",\"marketState\":"
+		out.RawString(prefix)
+		out.String(string(in.MarketState))
+	}
+	{
+		const prefix string = ",\"regularMarketTime\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.RegularMarketTime))
+	}
+	{
+		const prefix string = ",\"regularMarketPrice\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.RegularMarketPrice))
+	}
+	{
+		const prefix string = ",\"regularMarketChange\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.RegularMarketChange))
+	}
+	{
+		const prefix string = ",\"regularMarketChangePercent\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.RegularMarketChangePercent))
+	}
+	{
+		const prefix string = ",\"regularMarketPreviousClose\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.RegularMarketPreviousClose))
+	}
+	{
+		const prefix string = ",\"regularMarketOpen\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.RegularMarketOpen))
+	}
+	{
+		const prefix string = ",\"regularMarketDayLow\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.RegularMarketDayLow))
+	}
+	{
+		const prefix string = ",\"regularMarketDayHigh\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.RegularMarketDayHigh))
+	}
+	{
+		const prefix string = ",\"regularMarketVolume\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.RegularMarketVolume))
+	}
+	{
+		const prefix string = ",\"averageDailyVolume3Month\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.AverageDailyVolume3Month))
+	}
+	{
+		const prefix string = ",\"bid\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Bid))
+	}
+	{
+		const prefix string = ",\"ask\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Ask))
+	}
+	{
+		const prefix string = ",\"bidSize\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.BidSize))
+	}
+	{
+		const prefix string = ",\"askSize\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.AskSize))
+	}
+	{
+		const prefix string = ",\"marketCap\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.MarketCap))
+	}
+	{
+		const prefix string = ",\"fiftyTwoWeekLow\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.FiftyTwoWeekLow))
+	}
+	{
+		const prefix string = ",\"fiftyTwoWeekHigh\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.FiftyTwoWeekHigh))
+	}
+	{
+		const prefix string = ",\"preMarketPrice\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.PreMarketPrice))
+	}
+	{
+		const prefix string = ",\"preMarketTime\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.PreMarketTime))
+	}
+	{
+		const prefix string = ",\"postMarketPrice\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.PostMarketPrice))
+	}
+	{
+		const prefix string = ",\"postMarketTime\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.PostMarketTime))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v JSONSnapshot) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD3e3e4f0EncodeGithubComZeteliasGoyfinance2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v JSONSnapshot) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD3e3e4f0EncodeGithubComZeteliasGoyfinance2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *JSONSnapshot) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD3e3e4f0DecodeGithubComZeteliasGoyfinance2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *JSONSnapshot) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD3e3e4f0DecodeGithubComZeteliasGoyfinance2(l, v)
+}
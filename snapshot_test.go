@@ -0,0 +1,86 @@
+package goyfinance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/valyala/fasthttp"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestGetSnapshot(t *testing.T) {
+	var mu sync.Mutex
+	var requests int
+	client := newTestClient(t, func(ctx *fasthttp.RequestCtx) {
+		if string(ctx.Path()) != "/v7/finance/quote" {
+			ctx.SetBodyString("crumb")
+			return
+		}
+		mu.Lock()
+		requests++
+		mu.Unlock()
+
+		// Yahoo leaves out the symbols it does not know, and sends the others in its own order.
+		symbols := strings.Split(string(ctx.QueryArgs().Peek("symbols")), ",")
+		var results []string
+		for i := len(symbols) - 1; i >= 0; i-- {
+			if symbols[i] == "MISSING" {
+				continue
+			}
+			results = append(results, fmt.Sprintf(`{"symbol":%q,"quoteType":"EQUITY","exchange":"NMS","exchangeTimezoneName":"America/New_York","exchangeTimezoneShortName":"EST","gmtOffSetMilliseconds":-18000000,"marketState":"REGULAR","regularMarketTime":1704488400,"regularMarketPrice":%d.5,"bid":1,"ask":2,"marketCap":2800000000000}`, strings.ToUpper(symbols[i]), i))
+		}
+		ctx.SetBodyString(`{"quoteResponse":{"result":[` + strings.Join(results, ",") + `],"error":null}}`)
+	})
+
+	tickers := make([]string, 150)
+	for i := range tickers {
+		tickers[i] = fmt.Sprintf("t%d", i)
+	}
+	tickers[120] = "MISSING"
+	tickers[7] = "^GSPC"
+
+	snapshots, err := client.GetSnapshot(context.Background(), tickers...)
+	var batchErr *BatchError
+	if !errors.As(err, &batchErr) || len(batchErr.Errors) != 1 || batchErr.Errors[0].Index != 120 || !errors.Is(err, ErrTickerNotFound) {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if requests != 2 {
+		t.Errorf("sent %d quote requests", requests)
+	}
+	if len(snapshots) != 150 || snapshots[120].Symbol != "" {
+		t.Fatalf("unexpected snapshots %+v", snapshots)
+	}
+
+	first, gspc, last := snapshots[0], snapshots[7], snapshots[149]
+	if first.Ticker != "t0" || first.Symbol != "T0" || first.Price != 0.5 || last.Symbol != "T149" || last.Price != 49.5 {
+		t.Errorf("snapshots are out of order: %+v, %+v", first, last)
+	}
+	if gspc.Symbol != "^GSPC" {
+		t.Errorf("^GSPC was sent as %q", gspc.Symbol)
+	}
+	if first.MarketState != MarketOpen || first.Bid != 1 || first.Ask != 2 || first.MarketCap != 2800000000000 {
+		t.Errorf("unexpected snapshot %+v", first)
+	}
+	if first.Time.Location().String() != "America/New_York" || first.Time.Hour() != 16 || !first.PreMarketTime.IsZero() {
+		t.Errorf("times are %s and %s", first.Time, first.PreMarketTime)
+	}
+}
+
+func TestGetSnapshotError(t *testing.T) {
+	client := newTestClient(t, func(ctx *fasthttp.RequestCtx) {
+		if string(ctx.Path()) == "/v1/test/getcrumb" {
+			ctx.SetBodyString("crumb")
+			return
+		}
+		ctx.SetStatusCode(fasthttp.StatusUnauthorized)
+		ctx.SetBodyString(`{"quoteResponse":{"result":null,"error":{"code":"Unauthorized","description":"Invalid Crumb"}}}`)
+	}, WithRetryPolicy(RetryPolicy{MaxAttempts: 1}))
+
+	_, err := client.GetSnapshot(context.Background(), "AAPL", "MSFT")
+	var apiErr *APIError
+	if !errors.Is(err, ErrUnauthorized) || !errors.As(err, &apiErr) || apiErr.Description != "Invalid Crumb" {
+		t.Errorf("unexpected error %v", err)
+	}
+}
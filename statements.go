@@ -0,0 +1,180 @@
+package goyfinance
+
+import (
+	"context"
+	"fmt"
+	"sort"
+)
+
+const (
+	ModuleIncomeStatementHistory            SummaryModule = "incomeStatementHistory"            // Annual income statements
+	ModuleIncomeStatementHistoryQuarterly   SummaryModule = "incomeStatementHistoryQuarterly"   // Quarterly income statements
+	ModuleBalanceSheetHistory               SummaryModule = "balanceSheetHistory"               // Annual balance sheets
+	ModuleBalanceSheetHistoryQuarterly      SummaryModule = "balanceSheetHistoryQuarterly"      // Quarterly balance sheets
+	ModuleCashflowStatementHistory          SummaryModule = "cashflowStatementHistory"          // Annual cash-flow statements
+	ModuleCashflowStatementHistoryQuarterly SummaryModule = "cashflowStatementHistoryQuarterly" // Quarterly cash-flow statements
+)
+
+// StatementFrequency is how often the financial statements of a company are reported.
+type StatementFrequency string
+
+const (
+	StatementsAnnual    StatementFrequency = "annual"    // Fiscal years
+	StatementsQuarterly StatementFrequency = "quarterly" // Fiscal quarters
+)
+
+// IncomeStatement is the income statement of a fiscal period.
+// The quoteSummary endpoint only sends some of the fields nowadays,
+// GetFinancialStatementsTimeseries fills all of them.
+type IncomeStatement struct {
+	EndDate                      FormattedValue `json:"endDate"` // End of the fiscal period, see FormattedValue.Time
+	TotalRevenue                 FormattedValue `json:"totalRevenue"`
+	CostOfRevenue                FormattedValue `json:"costOfRevenue"`
+	GrossProfit                  FormattedValue `json:"grossProfit"`
+	ResearchDevelopment          FormattedValue `json:"researchDevelopment"`
+	SellingGeneralAdministrative FormattedValue `json:"sellingGeneralAdministrative"`
+	OperatingIncome              FormattedValue `json:"operatingIncome"`
+	InterestExpense              FormattedValue `json:"interestExpense"`
+	IncomeBeforeTax              FormattedValue `json:"incomeBeforeTax"`
+	IncomeTaxExpense             FormattedValue `json:"incomeTaxExpense"`
+	NetIncome                    FormattedValue `json:"netIncome"`
+	EBIT                         FormattedValue `json:"ebit"`
+	EBITDA                       FormattedValue `json:"ebitda"`
+	BasicEPS                     FormattedValue `json:"basicEPS"`
+	DilutedEPS                   FormattedValue `json:"dilutedEPS"`
+}
+
+// BalanceSheet is the balance sheet at the end of a fiscal period.
+// The quoteSummary endpoint only sends some of the fields nowadays,
+// GetFinancialStatementsTimeseries fills all of them.
+type BalanceSheet struct {
+	EndDate                 FormattedValue `json:"endDate"` // End of the fiscal period, see FormattedValue.Time
+	Cash                    FormattedValue `json:"cash"`
+	ShortTermInvestments    FormattedValue `json:"shortTermInvestments"`
+	NetReceivables          FormattedValue `json:"netReceivables"`
+	Inventory               FormattedValue `json:"inventory"`
+	TotalCurrentAssets      FormattedValue `json:"totalCurrentAssets"`
+	TotalAssets             FormattedValue `json:"totalAssets"`
+	AccountsPayable         FormattedValue `json:"accountsPayable"`
+	TotalCurrentLiabilities FormattedValue `json:"totalCurrentLiabilities"`
+	LongTermDebt            FormattedValue `json:"longTermDebt"`
+	TotalDebt               FormattedValue `json:"totalDebt"`
+	TotalLiabilities        FormattedValue `json:"totalLiab"`
+	RetainedEarnings        FormattedValue `json:"retainedEarnings"`
+	TotalStockholderEquity  FormattedValue `json:"totalStockholderEquity"`
+	SharesOutstanding       FormattedValue `json:"sharesOutstanding"`
+}
+
+// CashFlowStatement is the cash-flow statement of a fiscal period.
+// The quoteSummary endpoint only sends some of the fields nowadays,
+// GetFinancialStatementsTimeseries fills all of them.
+type CashFlowStatement struct {
+	EndDate             FormattedValue `json:"endDate"` // End of the fiscal period, see FormattedValue.Time
+	NetIncome           FormattedValue `json:"netIncome"`
+	Depreciation        FormattedValue `json:"depreciation"`
+	OperatingCashFlow   FormattedValue `json:"totalCashFromOperatingActivities"`
+	CapitalExpenditures FormattedValue `json:"capitalExpenditures"`
+	InvestingCashFlow   FormattedValue `json:"totalCashflowsFromInvestingActivities"`
+	DividendsPaid       FormattedValue `json:"dividendsPaid"`
+	RepurchaseOfStock   FormattedValue `json:"repurchaseOfStock"`
+	FinancingCashFlow   FormattedValue `json:"totalCashFromFinancingActivities"`
+	ChangeInCash        FormattedValue `json:"changeInCash"`
+	FreeCashFlow        FormattedValue `json:"freeCashFlow"`
+}
+
+// IncomeStatementHistory is the incomeStatementHistory
+// or the incomeStatementHistoryQuarterly module.
+type IncomeStatementHistory struct {
+	Statements []IncomeStatement `json:"incomeStatementHistory"` // Most recent first
+}
+
+// BalanceSheetHistory is the balanceSheetHistory
+// or the balanceSheetHistoryQuarterly module.
+type BalanceSheetHistory struct {
+	Statements []BalanceSheet `json:"balanceSheetStatements"` // Most recent first
+}
+
+// CashflowStatementHistory is the cashflowStatementHistory
+// or the cashflowStatementHistoryQuarterly module.
+type CashflowStatementHistory struct {
+	Statements []CashFlowStatement `json:"cashflowStatements"` // Most recent first
+}
+
+// FinancialStatements is the income statements, balance sheets
+// and cash-flow statements of a company, annual or quarterly.
+// Each slice holds one statement per fiscal period end, in chronological order.
+type FinancialStatements struct {
+	Ticker        string
+	Frequency     StatementFrequency
+	Income        []IncomeStatement
+	BalanceSheets []BalanceSheet
+	CashFlows     []CashFlowStatement
+}
+
+// sortStatements sorts statements by fiscal period end, oldest first.
+func sortStatements[T any](statements []T, endDate func(T) FormattedValue) {
+	sort.SliceStable(statements, func(i, j int) bool {
+		return endDate(statements[i]).Raw < endDate(statements[j]).Raw
+	})
+}
+
+// sortByEndDate sorts every statement of s by fiscal period end, oldest first.
+func (s *FinancialStatements) sortByEndDate() {
+	sortStatements(s.Income, func(statement IncomeStatement) FormattedValue { return statement.EndDate })
+	sortStatements(s.BalanceSheets, func(statement BalanceSheet) FormattedValue { return statement.EndDate })
+	sortStatements(s.CashFlows, func(statement CashFlowStatement) FormattedValue { return statement.EndDate })
+}
+
+// GetFinancialStatements returns the financial statements of a ticker from the quoteSummary endpoint,
+// which serves the last four fiscal years or quarters.
+// Use GetFinancialStatementsTimeseries for longer histories and more fields.
+// If an error occurs, the FinancialStatements struct will be empty.
+func (c *Client) GetFinancialStatements(ctx context.Context, ticker string, frequency StatementFrequency) (FinancialStatements, error) {
+	var modules []SummaryModule
+	switch frequency {
+	case StatementsAnnual:
+		modules = []SummaryModule{ModuleIncomeStatementHistory, ModuleBalanceSheetHistory, ModuleCashflowStatementHistory}
+	case StatementsQuarterly:
+		modules = []SummaryModule{ModuleIncomeStatementHistoryQuarterly, ModuleBalanceSheetHistoryQuarterly, ModuleCashflowStatementHistoryQuarterly}
+	default:
+		return FinancialStatements{}, fmt.Errorf("goyfinance: unknown statement frequency %q", string(frequency))
+	}
+
+	summary, err := c.GetQuoteSummary(ctx, ticker, modules...)
+	if err != nil {
+		return FinancialStatements{}, err
+	}
+
+	income, balanceSheets, cashFlows := summary.IncomeStatementHistory, summary.BalanceSheetHistory, summary.CashflowStatementHistory
+	if frequency == StatementsQuarterly {
+		income, balanceSheets, cashFlows = summary.IncomeStatementHistoryQuarterly, summary.BalanceSheetHistoryQuarterly, summary.CashflowStatementHistoryQuarterly
+	}
+	statements := FinancialStatements{Ticker: ticker, Frequency: frequency}
+	if income != nil {
+		statements.Income = income.Statements
+	}
+	if balanceSheets != nil {
+		statements.BalanceSheets = balanceSheets.Statements
+	}
+	if cashFlows != nil {
+		statements.CashFlows = cashFlows.Statements
+	}
+	if len(statements.Income) == 0 && len(statements.BalanceSheets) == 0 && len(statements.CashFlows) == 0 {
+		return FinancialStatements{}, fmt.Errorf("goyfinance: %s: %w", ticker, ErrNoData)
+	}
+	statements.sortByEndDate()
+	return statements, nil
+}
+
+// GetFinancialStatements returns the financial statements of a ticker from the quoteSummary endpoint
+// using the default client.
+// If an error occurs, the FinancialStatements struct will be empty.
+func GetFinancialStatements(ticker string, frequency StatementFrequency) (FinancialStatements, error) {
+	return GetFinancialStatementsCtx(context.Background(), ticker, frequency)
+}
+
+// GetFinancialStatementsCtx is like GetFinancialStatements but takes a context
+// to cancel the request or set its deadline.
+func GetFinancialStatementsCtx(ctx context.Context, ticker string, frequency StatementFrequency) (FinancialStatements, error) {
+	return defaultClient.GetFinancialStatements(ctx, ticker, frequency)
+}
@@ -0,0 +1,967 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package goyfinance
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson2a2d95f6DecodeGithubComZeteliasGoyfinance(in *jlexer.Lexer, out *IncomeStatementHistory) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "incomeStatementHistory":
+			if in.IsNull() {
+				in.Skip()
+				out.Statements = nil
+			} else {
+				in.Delim('[')
+				if out.Statements == nil {
+					if !in.IsDelim(']') {
+						out.Statements = make([]IncomeStatement, 0, 0)
+					} else {
+						out.Statements = []IncomeStatement{}
+					}
+				} else {
+					out.Statements = (out.Statements)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 IncomeStatement
+					(v1).UnmarshalEasyJSON(in)
+					out.Statements = append(out.Statements, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2a2d95f6EncodeGithubComZeteliasGoyfinance(out *jwriter.Writer, in IncomeStatementHistory) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"incomeStatementHistory\":"
+		out.RawString(prefix[1:])
+		if in.Statements == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v2, v3 := range in.Statements {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				(v3).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v IncomeStatementHistory) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2a2d95f6EncodeGithubComZeteliasGoyfinance(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v IncomeStatementHistory) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a2d95f6EncodeGithubComZeteliasGoyfinance(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *IncomeStatementHistory) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson2a2d95f6DecodeGithubComZeteliasGoyfinance(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *IncomeStatementHistory) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a2d95f6DecodeGithubComZeteliasGoyfinance(l, v)
+}
+func easyjson2a2d95f6DecodeGithubComZeteliasGoyfinance1(in *jlexer.Lexer, out *IncomeStatement) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "endDate":
+			(out.EndDate).UnmarshalEasyJSON(in)
+		case "totalRevenue":
+			(out.TotalRevenue).UnmarshalEasyJSON(in)
+		case "costOfRevenue":
+			(out.CostOfRevenue).UnmarshalEasyJSON(in)
+		case "grossProfit":
+			(out.GrossProfit).UnmarshalEasyJSON(in)
+		case "researchDevelopment":
+			(out.ResearchDevelopment).UnmarshalEasyJSON(in)
+		case "sellingGeneralAdministrative":
+			(out.SellingGeneralAdministrative).UnmarshalEasyJSON(in)
+		case "operatingIncome":
+			(out.OperatingIncome).UnmarshalEasyJSON(in)
+		case "interestExpense":
+			(out.InterestExpense).UnmarshalEasyJSON(in)
+		case "incomeBeforeTax":
+			(out.IncomeBeforeTax).UnmarshalEasyJSON(in)
+		case "incomeTaxExpense":
+			(out.IncomeTaxExpense).UnmarshalEasyJSON(in)
+		case "netIncome":
+			(out.NetIncome).UnmarshalEasyJSON(in)
+		case "ebit":
+			(out.EBIT).UnmarshalEasyJSON(in)
+		case "ebitda":
+			(out.EBITDA).UnmarshalEasyJSON(in)
+		case "basicEPS":
+			(out.BasicEPS).UnmarshalEasyJSON(in)
+		case "dilutedEPS":
+			(out.DilutedEPS).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2a2d95f6EncodeGithubComZeteliasGoyfinance1(out *jwriter.Writer, in IncomeStatement) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"endDate\":"
+		out.RawString(prefix[1:])
+		(in.EndDate).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"totalRevenue\":"
+		out.RawString(prefix)
+		(in.TotalRevenue).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"costOfRevenue\":"
+		out.RawString(prefix)
+		(in.CostOfRevenue).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"grossProfit\":"
+		out.RawString(prefix)
+		(in.GrossProfit).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"researchDevelopment\":"
+		out.RawString(prefix)
+		(in.ResearchDevelopment).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"sellingGeneralAdministrative\":"
+		out.RawString(prefix)
+		(in.SellingGeneralAdministrative).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"operatingIncome\":"
+		out.RawString(prefix)
+		(in.OperatingIncome).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"interestExpense\":"
+		out.RawString(prefix)
+		(in.InterestExpense).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"incomeBeforeTax\":"
+		out.RawString(prefix)
+		(in.IncomeBeforeTax).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"incomeTaxExpense\":"
+		out.RawString(prefix)
+		(in.IncomeTaxExpense).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"netIncome\":"
+		out.RawString(prefix)
+		(in.NetIncome).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"ebit\":"
+		out.RawString(prefix)
+		(in.EBIT).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"ebitda\":"
+		out.RawString(prefix)
+		(in.EBITDA).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"basicEPS\":"
+		out.RawString(prefix)
+		(in.BasicEPS).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"dilutedEPS\":"
+		out.RawString(prefix)
+		(in.DilutedEPS).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v IncomeStatement) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2a2d95f6EncodeGithubComZeteliasGoyfinance1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v IncomeStatement) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a2d95f6EncodeGithubComZeteliasGoyfinance1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *IncomeStatement) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson2a2d95f6DecodeGithubComZeteliasGoyfinance1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *IncomeStatement) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a2d95f6DecodeGithubComZeteliasGoyfinance1(l, v)
+}
+func easyjson2a2d95f6DecodeGithubComZeteliasGoyfinance2(in *jlexer.Lexer, out *FinancialStatements) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "Ticker":
+			out.Ticker = string(in.String())
+		case "Frequency":
+			out.Frequency = StatementFrequency(in.String())
+		case "Income":
+			if in.IsNull() {
+				in.Skip()
+				out.Income = nil
+			} else {
+				in.Delim('[')
+				if out.Income == nil {
+					if !in.IsDelim(']') {
+						out.Income = make([]IncomeStatement, 0, 0)
+					} else {
+						out.Income = []IncomeStatement{}
+					}
+				} else {
+					out.Income = (out.Income)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v4 IncomeStatement
+					(v4).UnmarshalEasyJSON(in)
+					out.Income = append(out.Income, v4)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "BalanceSheets":
+			if in.IsNull() {
+				in.Skip()
+				out.BalanceSheets = nil
+			} else {
+				in.Delim('[')
+				if out.BalanceSheets == nil {
+					if !in.IsDelim(']') {
+						out.BalanceSheets = make([]BalanceSheet, 0, 0)
+					} else {
+						out.BalanceSheets = []BalanceSheet{}
+					}
+				} else {
+					out.BalanceSheets = (out.BalanceSheets)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v5 BalanceSheet
+					(v5).UnmarshalEasyJSON(in)
+					out.BalanceSheets = append(out.BalanceSheets, v5)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "CashFlows":
+			if in.IsNull() {
+				in.Skip()
+				out.CashFlows = nil
+			} else {
+				in.Delim('[')
+				if out.CashFlows == nil {
+					if !in.IsDelim(']') {
+						out.CashFlows = make([]CashFlowStatement, 0, 0)
+					} else {
+						out.CashFlows = []CashFlowStatement{}
+					}
+				} else {
+					out.CashFlows = (out.CashFlows)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v6 CashFlowStatement
+					(v6).UnmarshalEasyJSON(in)
+					out.CashFlows = append(out.CashFlows, v6)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2a2d95f6EncodeGithubComZeteliasGoyfinance2(out *jwriter.Writer, in FinancialStatements) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Ticker\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Ticker))
+	}
+	{
+		const prefix string = ",\"Frequency\":"
+		out.RawString(prefix)
+		out.String(string(in.Frequency))
+	}
+	{
+		const prefix string = ",\"Income\":"
+		out.RawString(prefix)
+		if in.Income == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v7, v8 := range in.Income {
+				if v7 > 0 {
+					out.RawByte(',')
+				}
+				(v8).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"BalanceSheets\":"
+		out.RawString(prefix)
+		if in.BalanceSheets == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v9, v10 := range in.BalanceSheets {
+				if v9 > 0 {
+					out.RawByte(',')
+				}
+				(v10).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"CashFlows\":"
+		out.RawString(prefix)
+		if in.CashFlows == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v11, v12 := range in.CashFlows {
+				if v11 > 0 {
+					out.RawByte(',')
+				}
+				(v12).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v FinancialStatements) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2a2d95f6EncodeGithubComZeteliasGoyfinance2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v FinancialStatements) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a2d95f6EncodeGithubComZeteliasGoyfinance2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *FinancialStatements) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson2a2d95f6DecodeGithubComZeteliasGoyfinance2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *FinancialStatements) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a2d95f6DecodeGithubComZeteliasGoyfinance2(l, v)
+}
+func easyjson2a2d95f6DecodeGithubComZeteliasGoyfinance3(in *jlexer.Lexer, out *CashflowStatementHistory) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "cashflowStatements":
+			if in.IsNull() {
+				in.Skip()
+				out.Statements = nil
+			} else {
+				in.Delim('[')
+				if out.Statements == nil {
+					if !in.IsDelim(']') {
+						out.Statements = make([]CashFlowStatement, 0, 0)
+					} else {
+						out.Statements = []CashFlowStatement{}
+					}
+				} else {
+					out.Statements = (out.Statements)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v13 CashFlowStatement
+					(v13).UnmarshalEasyJSON(in)
+					out.Statements = append(out.Statements, v13)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2a2d95f6EncodeGithubComZeteliasGoyfinance3(out *jwriter.Writer, in CashflowStatementHistory) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"cashflowStatements\":"
+		out.RawString(prefix[1:])
+		if in.Statements == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v14, v15 := range in.Statements {
+				if v14 > 0 {
+					out.RawByte(',')
+				}
+				(v15).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CashflowStatementHistory) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2a2d95f6EncodeGithubComZeteliasGoyfinance3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CashflowStatementHistory) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a2d95f6EncodeGithubComZeteliasGoyfinance3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CashflowStatementHistory) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson2a2d95f6DecodeGithubComZeteliasGoyfinance3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CashflowStatementHistory) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a2d95f6DecodeGithubComZeteliasGoyfinance3(l, v)
+}
+func easyjson2a2d95f6DecodeGithubComZeteliasGoyfinance4(in *jlexer.Lexer, out *CashFlowStatement) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "endDate":
+			(out.EndDate).UnmarshalEasyJSON(in)
+		case "netIncome":
+			(out.NetIncome).UnmarshalEasyJSON(in)
+		case "depreciation":
+			(out.Depreciation).UnmarshalEasyJSON(in)
+		case "totalCashFromOperatingActivities":
+			(out.OperatingCashFlow).UnmarshalEasyJSON(in)
+		case "capitalExpenditures":
+			(out.CapitalExpenditures).UnmarshalEasyJSON(in)
+		case "totalCashflowsFromInvestingActivities":
+			(out.InvestingCashFlow).UnmarshalEasyJSON(in)
+		case "dividendsPaid":
+			(out.DividendsPaid).UnmarshalEasyJSON(in)
+		case "repurchaseOfStock":
+			(out.RepurchaseOfStock).UnmarshalEasyJSON(in)
+		case "totalCashFromFinancingActivities":
+			(out.FinancingCashFlow).UnmarshalEasyJSON(in)
+		case "changeInCash":
+			(out.ChangeInCash).UnmarshalEasyJSON(in)
+		case "freeCashFlow":
+			(out.FreeCashFlow).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2a2d95f6EncodeGithubComZeteliasGoyfinance4(out *jwriter.Writer, in CashFlowStatement) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"endDate\":"
+		out.RawString(prefix[1:])
+		(in.EndDate).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"netIncome\":"
+		out.RawString(prefix)
+		(in.NetIncome).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"depreciation\":"
+		out.RawString(prefix)
+		(in.Depreciation).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"totalCashFromOperatingActivities\":"
+		out.RawString(prefix)
+		(in.OperatingCashFlow).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"capitalExpenditures\":"
+		out.RawString(prefix)
+		(in.CapitalExpenditures).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"totalCashflowsFromInvestingActivities\":"
+		out.RawString(prefix)
+		(in.InvestingCashFlow).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"dividendsPaid\":"
+		out.RawString(prefix)
+		(in.DividendsPaid).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"repurchaseOfStock\":"
+		out.RawString(prefix)
+		(in.RepurchaseOfStock).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"totalCashFromFinancingActivities\":"
+		out.RawString(prefix)
+		(in.FinancingCashFlow).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"changeInCash\":"
+		out.RawString(prefix)
+		(in.ChangeInCash).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"freeCashFlow\":"
+		out.RawString(prefix)
+		(in.FreeCashFlow).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CashFlowStatement) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2a2d95f6EncodeGithubComZeteliasGoyfinance4(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CashFlowStatement) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a2d95f6EncodeGithubComZeteliasGoyfinance4(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CashFlowStatement) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson2a2d95f6DecodeGithubComZeteliasGoyfinance4(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CashFlowStatement) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a2d95f6DecodeGithubComZeteliasGoyfinance4(l, v)
+}
+func easyjson2a2d95f6DecodeGithubComZeteliasGoyfinance5(in *jlexer.Lexer, out *BalanceSheetHistory) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "balanceSheetStatements":
+			if in.IsNull() {
+				in.Skip()
+				out.Statements = nil
+			} else {
+				in.Delim('[')
+				if out.Statements == nil {
+					if !in.IsDelim(']') {
+						out.Statements = make([]BalanceSheet, 0, 0)
+					} else {
+						out.Statements = []BalanceSheet{}
+					}
+				} else {
+					out.Statements = (out.Statements)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v16 BalanceSheet
+					(v16).UnmarshalEasyJSON(in)
+					out.Statements = append(out.Statements, v16)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2a2d95f6EncodeGithubComZeteliasGoyfinance5(out *jwriter.Writer, in BalanceSheetHistory) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"balanceSheetStatements\":"
+		out.RawString(prefix[1:])
+		if in.Statements == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v17, v18 := range in.Statements {
+				if v17 > 0 {
+					out.RawByte(',')
+				}
+				(v18).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v BalanceSheetHistory) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2a2d95f6EncodeGithubComZeteliasGoyfinance5(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v BalanceSheetHistory) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a2d95f6EncodeGithubComZeteliasGoyfinance5(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *BalanceSheetHistory) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson2a2d95f6DecodeGithubComZeteliasGoyfinance5(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *BalanceSheetHistory) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a2d95f6DecodeGithubComZeteliasGoyfinance5(l, v)
+}
+func easyjson2a2d95f6DecodeGithubComZeteliasGoyfinance6(in *jlexer.Lexer, out *BalanceSheet) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "endDate":
+			(out.EndDate).UnmarshalEasyJSON(in)
+		case "cash":
+			(out.Cash).UnmarshalEasyJSON(in)
+		case "shortTermInvestments":
+			(out.ShortTermInvestments).UnmarshalEasyJSON(in)
+		case "netReceivables":
+			(out.NetReceivables).UnmarshalEasyJSON(in)
+		case "inventory":
+			(out.Inventory).UnmarshalEasyJSON(in)
+		case "totalCurrentAssets":
+			(out.TotalCurrentAssets).UnmarshalEasyJSON(in)
+		case "totalAssets":
+			(out.TotalAssets).UnmarshalEasyJSON(in)
+		case "accountsPayable":
+			(out.AccountsPayable).UnmarshalEasyJSON(in)
+		case "totalCurrentLiabilities":
+			(out.TotalCurrentLiabilities).UnmarshalEasyJSON(in)
+		case "longTermDebt":
+			(out.LongTermDebt).UnmarshalEasyJSON(in)
+		case "totalDebt":
+			(out.TotalDebt).UnmarshalEasyJSON(in)
+		case "totalLiab":
+			(out.TotalLiabilities).UnmarshalEasyJSON(in)
+		case "retainedEarnings":
+			(out.RetainedEarnings).UnmarshalEasyJSON(in)
+		case "totalStockholderEquity":
+			(out.TotalStockholderEquity).UnmarshalEasyJSON(in)
+		case "sharesOutstanding":
+			(out.SharesOutstanding).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2a2d95f6EncodeGithubComZeteliasGoyfinance6(out *jwriter.Writer, in BalanceSheet) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"endDate\":"
+		out.RawString(prefix[1:])
+		(in.EndDate).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"cash\":"
+		out.RawString(prefix)
+		(in.Cash).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"shortTermInvestments\":"
+		out.RawString(prefix)
+		(in.ShortTermInvestments).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"netReceivables\":"
+		out.RawString(prefix)
+		(in.NetReceivables).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"inventory\":"
+		out.RawString(prefix)
+		(in.Inventory).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"totalCurrentAssets\":"
+		out.RawString(prefix)
+		(in.TotalCurrentAssets).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"totalAssets\":"
+		out.RawString(prefix)
+		(in.TotalAssets).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"accountsPayable\":"
+		out.RawString(prefix)
+		(in.AccountsPayable).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"totalCurrentLiabilities\":"
+		out.RawString(prefix)
+		(in.TotalCurrentLiabilities).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"longTermDebt\":"
+		out.RawString(prefix)
+		(in.LongTermDebt).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"totalDebt\":"
+		out.RawString(prefix)
+		(in.TotalDebt).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"totalLiab\":"
+		out.RawString(prefix)
+		(in.TotalLiabilities).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"retainedEarnings\":"
+		out.RawString(prefix)
+		(in.RetainedEarnings).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"totalStockholderEquity\":"
+		out.RawString(prefix)
+		(in.TotalStockholderEquity).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"sharesOutstanding\":"
+		out.RawString(prefix)
+		(in.SharesOutstanding).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v BalanceSheet) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2a2d95f6EncodeGithubComZeteliasGoyfinance6(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v BalanceSheet) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a2d95f6EncodeGithubComZeteliasGoyfinance6(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *BalanceSheet) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson2a2d95f6DecodeGithubComZeteliasGoyfinance6(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *BalanceSheet) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a2d95f6DecodeGithubComZeteliasGoyfinance6(l, v)
+}
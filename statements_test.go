@@ -0,0 +1,96 @@
+package goyfinance
+
+import (
+	"context"
+	"errors"
+	"github.com/valyala/fasthttp"
+	"strings"
+	"testing"
+)
+
+func TestGetFinancialStatements(t *testing.T) {
+	var gotModules string
+	client := newTestClient(t, func(ctx *fasthttp.RequestCtx) {
+		if string(ctx.Path()) == "/v1/test/getcrumb" {
+			ctx.SetBodyString("crumb")
+			return
+		}
+		gotModules = string(ctx.QueryArgs().Peek("modules"))
+		ctx.SetBodyString(`{"quoteSummary":{"result":[{` +
+			`"incomeStatementHistoryQuarterly":{"incomeStatementHistory":[` +
+			`{"endDate":{"raw":1703894400,"fmt":"2023-12-30"},"totalRevenue":{"raw":119575000000,"fmt":"119.58B"},"netIncome":{"raw":33916000000,"fmt":"33.92B"}},` +
+			`{"endDate":{"raw":1696032000,"fmt":"2023-09-30"},"totalRevenue":{"raw":89498000000,"fmt":"89.5B"},"netIncome":{"raw":22956000000,"fmt":"22.96B"}}]},` +
+			`"balanceSheetHistoryQuarterly":{"balanceSheetStatements":[{"endDate":{"raw":1703894400,"fmt":"2023-12-30"},"totalAssets":{"raw":353514000000,"fmt":"353.51B"}}]},` +
+			`"cashflowStatementHistoryQuarterly":{"cashflowStatements":[]}` +
+			`}],"error":null}}`)
+	})
+
+	statements, err := client.GetFinancialStatements(context.Background(), "AAPL", StatementsQuarterly)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if gotModules != "incomeStatementHistoryQuarterly,balanceSheetHistoryQuarterly,cashflowStatementHistoryQuarterly" {
+		t.Errorf("sent modules=%q", gotModules)
+	}
+	if len(statements.Income) != 2 || statements.Income[0].EndDate.Fmt != "2023-09-30" || statements.Income[1].TotalRevenue.Raw != 119575000000 {
+		t.Errorf("unexpected income statements %+v", statements.Income)
+	}
+	if len(statements.BalanceSheets) != 1 || statements.BalanceSheets[0].TotalAssets.Fmt != "353.51B" || len(statements.CashFlows) != 0 {
+		t.Errorf("unexpected balance sheets %+v and cash flows %+v", statements.BalanceSheets, statements.CashFlows)
+	}
+
+	if _, err := client.GetFinancialStatements(context.Background(), "AAPL", "monthly"); err == nil {
+		t.Error("no error for an unknown frequency")
+	}
+}
+
+func TestGetFinancialStatementsTimeseries(t *testing.T) {
+	var gotTypes, gotSymbol string
+	client := newTestClient(t, func(ctx *fasthttp.RequestCtx) {
+		gotTypes = string(ctx.QueryArgs().Peek("type"))
+		gotSymbol = string(ctx.QueryArgs().Peek("symbol"))
+		if gotSymbol == "EMPTY" {
+			ctx.SetBodyString(`{"timeseries":{"result":[{"meta":{"symbol":["EMPTY"],"type":["annualTotalRevenue"]}}],"error":null}}`)
+			return
+		}
+		ctx.SetBodyString(`{"timeseries":{"result":[` +
+			`{"meta":{"symbol":["AAPL"],"type":["annualTotalRevenue"]},"timestamp":[1664496000,1696032000],"annualTotalRevenue":[` +
+			`{"dataId":20100,"asOfDate":"2023-09-30","periodType":"12M","currencyCode":"USD","reportedValue":{"raw":383285000000,"fmt":"383.29B"}},` +
+			`null,` +
+			`{"dataId":20100,"asOfDate":"2022-09-30","periodType":"12M","currencyCode":"USD","reportedValue":{"raw":394328000000,"fmt":"394.33B"}}]},` +
+			`{"meta":{"symbol":["AAPL"],"type":["annualTotalAssets"]},"timestamp":[1696032000],"annualTotalAssets":[` +
+			`{"dataId":20976,"asOfDate":"2023-09-30","periodType":"12M","currencyCode":"USD","reportedValue":{"raw":352583000000,"fmt":"352.58B"}}]},` +
+			`{"meta":{"symbol":["AAPL"],"type":["annualFreeCashFlow"]}}` +
+			`],"error":null}}`)
+	})
+
+	statements, err := client.GetFinancialStatementsTimeseries(context.Background(), "AAPL", StatementsAnnual, PeriodFiveYear)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if gotSymbol != "AAPL" || !strings.HasPrefix(gotTypes, "annualTotalRevenue,annualCostOfRevenue,") || !strings.Contains(gotTypes, "annualFreeCashFlow") {
+		t.Errorf("sent symbol=%q and type=%q", gotSymbol, gotTypes)
+	}
+	income := statements.Income
+	if len(income) != 2 || income[0].EndDate.Fmt != "2022-09-30" || income[0].TotalRevenue.Raw != 394328000000 ||
+		income[1].EndDate.Time().Format("2006-01-02") != "2023-09-30" || income[1].TotalRevenue.Fmt != "383.29B" {
+		t.Errorf("unexpected income statements %+v", income)
+	}
+	if len(statements.BalanceSheets) != 1 || statements.BalanceSheets[0].TotalAssets.Raw != 352583000000 || len(statements.CashFlows) != 0 {
+		t.Errorf("unexpected balance sheets %+v and cash flows %+v", statements.BalanceSheets, statements.CashFlows)
+	}
+
+	series, err := client.GetFundamentalsTimeseries(context.Background(), "AAPL", PeriodFiveYear, "annualTotalRevenue")
+	if err != nil {
+		t.Fatal(err)
+	}
+	revenue := series["annualTotalRevenue"]
+	if len(revenue) != 2 || revenue[0].PeriodType != "12M" || revenue[0].CurrencyCode != "USD" || revenue[1].AsOfDate.Year() != 2023 {
+		t.Errorf("unexpected series %+v", revenue)
+	}
+
+	_, err = client.GetFinancialStatementsTimeseries(context.Background(), "EMPTY", StatementsAnnual, PeriodFiveYear)
+	if !errors.Is(err, ErrNoData) {
+		t.Errorf("expected ErrNoData, got %v", err)
+	}
+}
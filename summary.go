@@ -0,0 +1,288 @@
+package goyfinance
+
+import (
+	"context"
+	"fmt"
+	"github.com/mailru/easyjson"
+	"github.com/valyala/fasthttp"
+	"strings"
+)
+
+// SummaryModule is a module of the quoteSummary endpoint,
+// each holding a part of the data about a ticker.
+type SummaryModule string
+
+const (
+	ModuleAssetProfile         SummaryModule = "assetProfile"         // Company profile and officers
+	ModuleSummaryDetail        SummaryModule = "summaryDetail"        // Trading and dividend figures
+	ModuleDefaultKeyStatistics SummaryModule = "defaultKeyStatistics" // Valuation and share statistics
+	ModuleFinancialData        SummaryModule = "financialData"        // Financial figures and analyst targets
+	ModulePrice                SummaryModule = "price"                // Price and market state
+)
+
+// defaultSummaryModules are the modules requested when none is given.
+var defaultSummaryModules = []SummaryModule{
+	ModuleAssetProfile,
+	ModuleSummaryDetail,
+	ModuleDefaultKeyStatistics,
+	ModuleFinancialData,
+	ModulePrice,
+}
+
+// QuoteSummary is the data of a ticker from the quoteSummary endpoint.
+// Modules that were not requested, or that Yahoo does not have
+// for the ticker, like the financial data of an index, are nil.
+type QuoteSummary struct {
+	AssetProfile         *AssetProfile         `json:"assetProfile"`
+	SummaryDetail        *SummaryDetail        `json:"summaryDetail"`
+	DefaultKeyStatistics *DefaultKeyStatistics `json:"defaultKeyStatistics"`
+	FinancialData        *FinancialData        `json:"financialData"`
+	Price                *SummaryPrice         `json:"price"`
+
+	IncomeStatementHistory            *IncomeStatementHistory   `json:"incomeStatementHistory"`
+	IncomeStatementHistoryQuarterly   *IncomeStatementHistory   `json:"incomeStatementHistoryQuarterly"`
+	BalanceSheetHistory               *BalanceSheetHistory      `json:"balanceSheetHistory"`
+	BalanceSheetHistoryQuarterly      *BalanceSheetHistory      `json:"balanceSheetHistoryQuarterly"`
+	CashflowStatementHistory          *CashflowStatementHistory `json:"cashflowStatementHistory"`
+	CashflowStatementHistoryQuarterly *CashflowStatementHistory `json:"cashflowStatementHistoryQuarterly"`
+}
+
+// jsonQuoteSummaryResponse is the response of the quoteSummary endpoint.
+type jsonQuoteSummaryResponse struct {
+	QuoteSummary struct {
+		Result []QuoteSummary `json:"result"`
+		Error  *JSONError     `json:"error"`
+	} `json:"quoteSummary"`
+}
+
+// AssetProfile is the assetProfile module:
+// the address, sector and officers of a company.
+type AssetProfile struct {
+	Address1            string           `json:"address1"`
+	City                string           `json:"city"`
+	State               string           `json:"state"`
+	Zip                 string           `json:"zip"`
+	Country             string           `json:"country"`
+	Phone               string           `json:"phone"`
+	Website             string           `json:"website"`
+	Industry            string           `json:"industry"`
+	IndustryKey         string           `json:"industryKey"`
+	Sector              string           `json:"sector"`
+	SectorKey           string           `json:"sectorKey"`
+	LongBusinessSummary string           `json:"longBusinessSummary"`
+	FullTimeEmployees   int64            `json:"fullTimeEmployees"`
+	CompanyOfficers     []CompanyOfficer `json:"companyOfficers"`
+
+	// Governance risks, from 1 (low) to 10 (high).
+	AuditRisk             int `json:"auditRisk"`
+	BoardRisk             int `json:"boardRisk"`
+	CompensationRisk      int `json:"compensationRisk"`
+	ShareHolderRightsRisk int `json:"shareHolderRightsRisk"`
+	OverallRisk           int `json:"overallRisk"`
+}
+
+// CompanyOfficer is an officer of a company, from the assetProfile module.
+type CompanyOfficer struct {
+	Name     string         `json:"name"`
+	Title    string         `json:"title"`
+	Age      int            `json:"age"`
+	YearBorn int            `json:"yearBorn"`
+	TotalPay FormattedValue `json:"totalPay"`
+}
+
+// SummaryDetail is the summaryDetail module:
+// the trading, valuation and dividend figures shown on the summary page of a ticker.
+type SummaryDetail struct {
+	Currency                     string         `json:"currency"`
+	PreviousClose                FormattedValue `json:"previousClose"`
+	Open                         FormattedValue `json:"open"`
+	DayLow                       FormattedValue `json:"dayLow"`
+	DayHigh                      FormattedValue `json:"dayHigh"`
+	Volume                       FormattedValue `json:"volume"`
+	AverageVolume                FormattedValue `json:"averageVolume"`
+	AverageVolume10Days          FormattedValue `json:"averageVolume10days"`
+	Bid                          FormattedValue `json:"bid"`
+	Ask                          FormattedValue `json:"ask"`
+	BidSize                      FormattedValue `json:"bidSize"`
+	AskSize                      FormattedValue `json:"askSize"`
+	MarketCap                    FormattedValue `json:"marketCap"`
+	FiftyTwoWeekLow              FormattedValue `json:"fiftyTwoWeekLow"`
+	FiftyTwoWeekHigh             FormattedValue `json:"fiftyTwoWeekHigh"`
+	FiftyDayAverage              FormattedValue `json:"fiftyDayAverage"`
+	TwoHundredDayAverage         FormattedValue `json:"twoHundredDayAverage"`
+	Beta                         FormattedValue `json:"beta"`
+	TrailingPE                   FormattedValue `json:"trailingPE"`
+	ForwardPE                    FormattedValue `json:"forwardPE"`
+	PriceToSalesTrailing12Months FormattedValue `json:"priceToSalesTrailing12Months"`
+	DividendRate                 FormattedValue `json:"dividendRate"`
+	DividendYield                FormattedValue `json:"dividendYield"`
+	ExDividendDate               FormattedValue `json:"exDividendDate"` // See FormattedValue.Time
+	PayoutRatio                  FormattedValue `json:"payoutRatio"`
+	FiveYearAvgDividendYield     FormattedValue `json:"fiveYearAvgDividendYield"`
+	TrailingAnnualDividendRate   FormattedValue `json:"trailingAnnualDividendRate"`
+	TrailingAnnualDividendYield  FormattedValue `json:"trailingAnnualDividendYield"`
+}
+
+// DefaultKeyStatistics is the defaultKeyStatistics module:
+// the valuation measures and share statistics of a ticker.
+type DefaultKeyStatistics struct {
+	EnterpriseValue         FormattedValue `json:"enterpriseValue"`
+	EnterpriseToRevenue     FormattedValue `json:"enterpriseToRevenue"`
+	EnterpriseToEbitda      FormattedValue `json:"enterpriseToEbitda"`
+	ForwardPE               FormattedValue `json:"forwardPE"`
+	PegRatio                FormattedValue `json:"pegRatio"`
+	PriceToBook             FormattedValue `json:"priceToBook"`
+	BookValue               FormattedValue `json:"bookValue"`
+	ProfitMargins           FormattedValue `json:"profitMargins"`
+	TrailingEps             FormattedValue `json:"trailingEps"`
+	ForwardEps              FormattedValue `json:"forwardEps"`
+	NetIncomeToCommon       FormattedValue `json:"netIncomeToCommon"`
+	EarningsQuarterlyGrowth FormattedValue `json:"earningsQuarterlyGrowth"`
+	SharesOutstanding       FormattedValue `json:"sharesOutstanding"`
+	FloatShares             FormattedValue `json:"floatShares"`
+	SharesShort             FormattedValue `json:"sharesShort"`
+	ShortRatio              FormattedValue `json:"shortRatio"`
+	ShortPercentOfFloat     FormattedValue `json:"shortPercentOfFloat"`
+	HeldPercentInsiders     FormattedValue `json:"heldPercentInsiders"`
+	HeldPercentInstitutions FormattedValue `json:"heldPercentInstitutions"`
+	Beta                    FormattedValue `json:"beta"`
+	FiftyTwoWeekChange      FormattedValue `json:"52WeekChange"`
+	SandP52WeekChange       FormattedValue `json:"SandP52WeekChange"`
+	LastFiscalYearEnd       FormattedValue `json:"lastFiscalYearEnd"` // See FormattedValue.Time
+	NextFiscalYearEnd       FormattedValue `json:"nextFiscalYearEnd"` // See FormattedValue.Time
+	MostRecentQuarter       FormattedValue `json:"mostRecentQuarter"` // See FormattedValue.Time
+	LastSplitFactor         string         `json:"lastSplitFactor"`   // For example "4:1"
+	LastSplitDate           FormattedValue `json:"lastSplitDate"`     // See FormattedValue.Time
+	LastDividendValue       FormattedValue `json:"lastDividendValue"`
+	LastDividendDate        FormattedValue `json:"lastDividendDate"` // See FormattedValue.Time
+}
+
+// FinancialData is the financialData module:
+// the financial figures of a company and the price targets of analysts.
+type FinancialData struct {
+	FinancialCurrency       string         `json:"financialCurrency"`
+	CurrentPrice            FormattedValue `json:"currentPrice"`
+	TargetLowPrice          FormattedValue `json:"targetLowPrice"`
+	TargetHighPrice         FormattedValue `json:"targetHighPrice"`
+	TargetMeanPrice         FormattedValue `json:"targetMeanPrice"`
+	TargetMedianPrice       FormattedValue `json:"targetMedianPrice"`
+	RecommendationMean      FormattedValue `json:"recommendationMean"`
+	RecommendationKey       string         `json:"recommendationKey"` // For example "buy"
+	NumberOfAnalystOpinions FormattedValue `json:"numberOfAnalystOpinions"`
+	TotalRevenue            FormattedValue `json:"totalRevenue"`
+	RevenuePerShare         FormattedValue `json:"revenuePerShare"`
+	RevenueGrowth           FormattedValue `json:"revenueGrowth"`
+	GrossProfits            FormattedValue `json:"grossProfits"`
+	Ebitda                  FormattedValue `json:"ebitda"`
+	EarningsGrowth          FormattedValue `json:"earningsGrowth"`
+	GrossMargins            FormattedValue `json:"grossMargins"`
+	EbitdaMargins           FormattedValue `json:"ebitdaMargins"`
+	OperatingMargins        FormattedValue `json:"operatingMargins"`
+	ProfitMargins           FormattedValue `json:"profitMargins"`
+	ReturnOnAssets          FormattedValue `json:"returnOnAssets"`
+	ReturnOnEquity          FormattedValue `json:"returnOnEquity"`
+	TotalCash               FormattedValue `json:"totalCash"`
+	TotalCashPerShare       FormattedValue `json:"totalCashPerShare"`
+	TotalDebt               FormattedValue `json:"totalDebt"`
+	DebtToEquity            FormattedValue `json:"debtToEquity"`
+	QuickRatio              FormattedValue `json:"quickRatio"`
+	CurrentRatio            FormattedValue `json:"currentRatio"`
+	OperatingCashflow       FormattedValue `json:"operatingCashflow"`
+	FreeCashflow            FormattedValue `json:"freeCashflow"`
+}
+
+// SummaryPrice is the price module:
+// the names, price and market state of a ticker.
+type SummaryPrice struct {
+	Symbol                     string         `json:"symbol"`
+	ShortName                  string         `json:"shortName"`
+	LongName                   string         `json:"longName"`
+	QuoteType                  string         `json:"quoteType"`
+	Exchange                   string         `json:"exchange"`
+	ExchangeName               string         `json:"exchangeName"`
+	Currency                   string         `json:"currency"`
+	CurrencySymbol             string         `json:"currencySymbol"`
+	MarketState                string         `json:"marketState"` // For example "REGULAR" or "POSTPOST"
+	RegularMarketTime          int64          `json:"regularMarketTime"`
+	RegularMarketPrice         FormattedValue `json:"regularMarketPrice"`
+	RegularMarketChange        FormattedValue `json:"regularMarketChange"`
+	RegularMarketChangePercent FormattedValue `json:"regularMarketChangePercent"`
+	RegularMarketPreviousClose FormattedValue `json:"regularMarketPreviousClose"`
+	RegularMarketOpen          FormattedValue `json:"regularMarketOpen"`
+	RegularMarketDayLow        FormattedValue `json:"regularMarketDayLow"`
+	RegularMarketDayHigh       FormattedValue `json:"regularMarketDayHigh"`
+	RegularMarketVolume        FormattedValue `json:"regularMarketVolume"`
+	PreMarketPrice             FormattedValue `json:"preMarketPrice"`
+	PostMarketPrice            FormattedValue `json:"postMarketPrice"`
+	MarketCap                  FormattedValue `json:"marketCap"`
+}
+
+// GetQuoteSummary returns the requested modules of the quoteSummary endpoint for a ticker,
+// or the assetProfile, summaryDetail, defaultKeyStatistics, financialData
+// and price modules if none is given.
+// If an error occurs, the QuoteSummary struct will be empty.
+func (c *Client) GetQuoteSummary(ctx context.Context, ticker string, modules ...SummaryModule) (QuoteSummary, error) {
+	if len(modules) == 0 {
+		modules = defaultSummaryModules
+	}
+	names := make([]string, len(modules))
+	for i, module := range modules {
+		names[i] = string(module)
+	}
+
+	resp := fasthttp.AcquireResponse()
+	defer fasthttp.ReleaseResponse(resp)
+
+	err := c.fetchWithCrumb(ctx, fmt.Sprintf("/v10/finance/quoteSummary/%s?modules=%s", ticker, strings.Join(names, ",")), resp)
+	if err != nil {
+		return QuoteSummary{}, err
+	}
+
+	var jsonResp jsonQuoteSummaryResponse
+	if err := easyjson.Unmarshal(resp.Body(), &jsonResp); err != nil {
+		return QuoteSummary{}, err
+	}
+	if jsonErr := jsonResp.QuoteSummary.Error; jsonErr != nil {
+		return QuoteSummary{}, &APIError{StatusCode: resp.StatusCode(), Code: jsonErr.Code, Description: jsonErr.Description}
+	}
+	if len(jsonResp.QuoteSummary.Result) == 0 {
+		return QuoteSummary{}, fmt.Errorf("goyfinance: %s: %w", ticker, ErrNoData)
+	}
+	return jsonResp.QuoteSummary.Result[0], nil
+}
+
+// GetQuoteSummary returns the requested modules of the quoteSummary endpoint for a ticker
+// using the default client.
+// If an error occurs, the QuoteSummary struct will be empty.
+func GetQuoteSummary(ticker string, modules ...SummaryModule) (QuoteSummary, error) {
+	return GetQuoteSummaryCtx(context.Background(), ticker, modules...)
+}
+
+// GetQuoteSummaryCtx is like GetQuoteSummary but takes a context
+// to cancel the request or set its deadline.
+func GetQuoteSummaryCtx(ctx context.Context, ticker string, modules ...SummaryModule) (QuoteSummary, error) {
+	return defaultClient.GetQuoteSummary(ctx, ticker, modules...)
+}
+
+// GetQuoteSummaryBatch returns the requested modules of the quoteSummary endpoint for every ticker.
+// The order of the slice is the same as the order of the tickers slice.
+// If a ticker fails, its QuoteSummary struct is empty and the error is a *BatchError.
+func (c *Client) GetQuoteSummaryBatch(ctx context.Context, tickers []string, modules ...SummaryModule) ([]QuoteSummary, error) {
+	return runBatch(ctx, c.maxConcurrency, tickers, func(ctx context.Context, ticker string) (QuoteSummary, error) {
+		return c.GetQuoteSummary(ctx, ticker, modules...)
+	})
+}
+
+// GetQuoteSummaryBatch returns the requested modules of the quoteSummary endpoint for every ticker
+// using the default client.
+// The order of the slice is the same as the order of the tickers slice.
+// If a ticker fails, its QuoteSummary struct is empty and the error is a *BatchError.
+func GetQuoteSummaryBatch(tickers []string, modules ...SummaryModule) ([]QuoteSummary, error) {
+	return GetQuoteSummaryBatchCtx(context.Background(), tickers, modules...)
+}
+
+// GetQuoteSummaryBatchCtx is like GetQuoteSummaryBatch but takes a context
+// to cancel the requests or set their deadline.
+func GetQuoteSummaryBatchCtx(ctx context.Context, tickers []string, modules ...SummaryModule) ([]QuoteSummary, error) {
+	return defaultClient.GetQuoteSummaryBatch(ctx, tickers, modules...)
+}
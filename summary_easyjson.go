@@ -0,0 +1,1755 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package goyfinance
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjsonF381ebcaDecodeGithubComZeteliasGoyfinance(in *jlexer.Lexer, out *jsonQuoteSummaryResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "quoteSummary":
+			easyjsonF381ebcaDecode(in, &out.QuoteSummary)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonF381ebcaEncodeGithubComZeteliasGoyfinance(out *jwriter.Writer, in jsonQuoteSummaryResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"quoteSummary\":"
+		out.RawString(prefix[1:])
+		easyjsonF381ebcaEncode(out, in.QuoteSummary)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v jsonQuoteSummaryResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonF381ebcaEncodeGithubComZeteliasGoyfinance(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v jsonQuoteSummaryResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonF381ebcaEncodeGithubComZeteliasGoyfinance(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *jsonQuoteSummaryResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonF381ebcaDecodeGithubComZeteliasGoyfinance(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *jsonQuoteSummaryResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonF381ebcaDecodeGithubComZeteliasGoyfinance(l, v)
+}
+func easyjsonF381ebcaDecode(in *jlexer.Lexer, out *struct {
+	Result []QuoteSummary `json:"result"`
+	Error  *JSONError     `json:"error"`
+}) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "result":
+			if in.IsNull() {
+				in.Skip()
+				out.Result = nil
+			} else {
+				in.Delim('[')
+				if out.Result == nil {
+					if !in.IsDelim(']') {
+						out.Result = make([]QuoteSummary, 0, 0)
+					} else {
+						out.Result = []QuoteSummary{}
+					}
+				} else {
+					out.Result = (out.Result)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 QuoteSummary
+					(v1).UnmarshalEasyJSON(in)
+					out.Result = append(out.Result, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "error":
+			if in.IsNull() {
+				in.Skip()
+				out.Error = nil
+			} else {
+				if out.Error == nil {
+					out.Error = new(JSONError)
+				}
+				(*out.Error).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonF381ebcaEncode(out *jwriter.Writer, in struct {
+	Result []QuoteSummary `json:"result"`
+	Error  *JSONError     `json:"error"`
+}) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"result\":"
+		out.RawString(prefix[1:])
+		if in.Result == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v2, v3 := range in.Result {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				(v3).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"error\":"
+		out.RawString(prefix)
+		if in.Error == nil {
+			out.RawString("null")
+		} else {
+			(*in.Error).MarshalEasyJSON(out)
+		}
+	}
+	out.RawByte('}')
+}
+func easyjsonF381ebcaDecodeGithubComZeteliasGoyfinance1(in *jlexer.Lexer, out *SummaryPrice) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "symbol":
+			out.Symbol = string(in.String())
+		case "shortName":
+			out.ShortName = string(in.String())
+		case "longName":
+			out.LongName = string(in.String())
+		case "quoteType":
+			out.QuoteType = string(in.String())
+		case "exchange":
+			out.Exchange = string(in.String())
+		case "exchangeName":
+			out.ExchangeName = string(in.String())
+		case "currency":
+			out.Currency = string(in.String())
+		case "currencySymbol":
+			out.CurrencySymbol = string(in.String())
+		case "marketState":
+			out.MarketState = string(in.String())
+		case "regularMarketTime":
+			out.RegularMarketTime = int64(in.Int64())
+		case "regularMarketPrice":
+			(out.RegularMarketPrice).UnmarshalEasyJSON(in)
+		case "regularMarketChange":
+			(out.RegularMarketChange).UnmarshalEasyJSON(in)
+		case "regularMarketChangePercent":
+			(out.RegularMarketChangePercent).UnmarshalEasyJSON(in)
+		case "regularMarketPreviousClose":
+			(out.RegularMarketPreviousClose).UnmarshalEasyJSON(in)
+		case "regularMarketOpen":
+			(out.RegularMarketOpen).UnmarshalEasyJSON(in)
+		case "regularMarketDayLow":
+			(out.RegularMarketDayLow).UnmarshalEasyJSON(in)
+		case "regularMarketDayHigh":
+			(out.RegularMarketDayHigh).UnmarshalEasyJSON(in)
+		case "regularMarketVolume":
+			(out.RegularMarketVolume).UnmarshalEasyJSON(in)
+		case "preMarketPrice":
+			(out.PreMarketPrice).UnmarshalEasyJSON(in)
+		case "postMarketPrice":
+			(out.PostMarketPrice).UnmarshalEasyJSON(in)
+		case "marketCap":
+			(out.MarketCap).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonF381ebcaEncodeGithubComZeteliasGoyfinance1(out *jwriter.Writer, in SummaryPrice) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"symbol\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Symbol))
+	}
+	{
+		const prefix string = ",\"shortName\":"
+		out.RawString(prefix)
+		out.String(string(in.ShortName))
+	}
+	{
+		const prefix string = ",\"longName\":"
+		out.RawString(prefix)
+		out.String(string(in.LongName))
+	}
+	{
+		const prefix string = ",\"quoteType\":"
+		out.RawString(prefix)
+		out.String(string(in.QuoteType))
+	}
+	{
+		const prefix string = ",\"exchange\":"
+		out.RawString(prefix)
+		out.String(string(in.Exchange))
+	}
+	{
+		const prefix string = ",\"exchangeName\":"
+		out.RawString(prefix)
+		out.String(string(in.ExchangeName))
+	}
+	{
+		const prefix string = ",\"currency\":"
+		out.RawString(prefix)
+		out.String(string(in.Currency))
+	}
+	{
+		const prefix string = ",\"currencySymbol\":"
+		out.RawString(prefix)
+		out.String(string(in.CurrencySymbol))
+	}
+	{
+		const prefix string = ",\"marketState\":"
+		out.RawString(prefix)
+		out.String(string(in.MarketState))
+	}
+	{
+		const prefix string = ",\"regularMarketTime\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.RegularMarketTime))
+	}
+	{
+		const prefix string = ",\"regularMarketPrice\":"
+		out.RawString(prefix)
+		(in.RegularMarketPrice).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"regularMarketChange\":"
+		out.RawString(prefix)
+		(in.RegularMarketChange).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"regularMarketChangePercent\":"
+		out.RawString(prefix)
+		(in.RegularMarketChangePercent).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"regularMarketPreviousClose\":"
+		out.RawString(prefix)
+		(in.RegularMarketPreviousClose).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"regularMarketOpen\":"
+		out.RawString(prefix)
+		(in.RegularMarketOpen).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"regularMarketDayLow\":"
+		out.RawString(prefix)
+		(in.RegularMarketDayLow).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"regularMarketDayHigh\":"
+		out.RawString(prefix)
+		(in.RegularMarketDayHigh).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"regularMarketVolume\":"
+		out.RawString(prefix)
+		(in.RegularMarketVolume).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"preMarketPrice\":"
+		out.RawString(prefix)
+		(in.PreMarketPrice).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"postMarketPrice\":"
+		out.RawString(prefix)
+		(in.PostMarketPrice).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"marketCap\":"
+		out.RawString(prefix)
+		(in.MarketCap).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SummaryPrice) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonF381ebcaEncodeGithubComZeteliasGoyfinance1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SummaryPrice) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonF381ebcaEncodeGithubComZeteliasGoyfinance1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SummaryPrice) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonF381ebcaDecodeGithubComZeteliasGoyfinance1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SummaryPrice) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonF381ebcaDecodeGithubComZeteliasGoyfinance1(l, v)
+}
+func easyjsonF381ebcaDecodeGithubComZeteliasGoyfinance2(in *jlexer.Lexer, out *SummaryDetail) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "currency":
+			out.Currency = string(in.String())
+		case "previousClose":
+			(out.PreviousClose).UnmarshalEasyJSON(in)
+		case "open":
+			(out.Open).UnmarshalEasyJSON(in)
+		case "dayLow":
+			(out.DayLow).UnmarshalEasyJSON(in)
+		case "dayHigh":
+			(out.DayHigh).UnmarshalEasyJSON(in)
+		case "volume":
+			(out.Volume).UnmarshalEasyJSON(in)
+		case "averageVolume":
+			(out.AverageVolume).UnmarshalEasyJSON(in)
+		case "averageVolume10days":
+			(out.AverageVolume10Days).UnmarshalEasyJSON(in)
+		case "bid":
+			(out.Bid).UnmarshalEasyJSON(in)
+		case "ask":
+			(out.Ask).UnmarshalEasyJSON(in)
+		case "bidSize":
+			(out.BidSize).UnmarshalEasyJSON(in)
+		case "askSize":
+			(out.AskSize).UnmarshalEasyJSON(in)
+		case "marketCap":
+			(out.MarketCap).UnmarshalEasyJSON(in)
+		case "fiftyTwoWeekLow":
+			(out.FiftyTwoWeekLow).UnmarshalEasyJSON(in)
+		case "fiftyTwoWeekHigh":
+			(out.FiftyTwoWeekHigh).UnmarshalEasyJSON(in)
+		case "fiftyDayAverage":
+			(out.FiftyDayAverage).UnmarshalEasyJSON(in)
+		case "twoHundredDayAverage":
+			(out.TwoHundredDayAverage).UnmarshalEasyJSON(in)
+		case "beta":
+			(out.Beta).UnmarshalEasyJSON(in)
+		case "trailingPE":
+			(out.TrailingPE).UnmarshalEasyJSON(in)
+		case "forwardPE":
+			(out.ForwardPE).UnmarshalEasyJSON(in)
+		case "priceToSalesTrailing12Months":
+			(out.PriceToSalesTrailing12Months).UnmarshalEasyJSON(in)
+		case "dividendRate":
+			(out.DividendRate).UnmarshalEasyJSON(in)
+		case "dividendYield":
+			(out.DividendYield).UnmarshalEasyJSON(in)
+		case "exDividendDate":
+			(out.ExDividendDate).UnmarshalEasyJSON(in)
+		case "payoutRatio":
+			(out.PayoutRatio).UnmarshalEasyJSON(in)
+		case "fiveYearAvgDividendYield":
+			(out.FiveYearAvgDividendYield).UnmarshalEasyJSON(in)
+		case "trailingAnnualDividendRate":
+			(out.TrailingAnnualDividendRate).UnmarshalEasyJSON(in)
+		case "trailingAnnualDividendYield":
+			(out.TrailingAnnualDividendYield).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonF381ebcaEncodeGithubComZeteliasGoyfinance2(out *jwriter.Writer, in SummaryDetail) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"currency\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Currency))
+	}
+	{
+		const prefix string = ",\"previousClose\":"
+		out.RawString(prefix)
+		(in.PreviousClose).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"open\":"
+		out.RawString(prefix)
+		(in.Open).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"dayLow\":"
+		out.RawString(prefix)
+		(in.DayLow).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"dayHigh\":"
+		out.RawString(prefix)
+		(in.DayHigh).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"volume\":"
+		out.RawString(prefix)
+		(in.Volume).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"averageVolume\":"
+		out.RawString(prefix)
+		(in.AverageVolume).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"averageVolume10days\":"
+		out.RawString(prefix)
+		(in.AverageVolume10Days).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"bid\":"
+		out.RawString(prefix)
+		(in.Bid).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"ask\":"
+		out.RawString(prefix)
+		(in.Ask).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"bidSize\":"
+		out.RawString(prefix)
+		(in.BidSize).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"askSize\":"
+		out.RawString(prefix)
+		(in.AskSize).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"marketCap\":"
+		out.RawString(prefix)
+		(in.MarketCap).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"fiftyTwoWeekLow\":"
+		out.RawString(prefix)
+		(in.FiftyTwoWeekLow).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"fiftyTwoWeekHigh\":"
+		out.RawString(prefix)
+		(in.FiftyTwoWeekHigh).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"fiftyDayAverage\":"
+		out.RawString(prefix)
+		(in.FiftyDayAverage).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"twoHundredDayAverage\":"
+		out.RawString(prefix)
+		(in.TwoHundredDayAverage).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"beta\":"
+		out.RawString(prefix)
+		(in.Beta).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"trailingPE\":"
+		out.RawString(prefix)
+		(in.TrailingPE).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"forwardPE\":"
+		out.RawString(prefix)
+		(in.ForwardPE).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"priceToSalesTrailing12Months\":"
+		out.RawString(prefix)
+		(in.PriceToSalesTrailing12Months).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"dividendRate\":"
+		out.RawString(prefix)
+		(in.DividendRate).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"dividendYield\":"
+		out.RawString(prefix)
+		(in.DividendYield).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"exDividendDate\":"
+		out.RawString(prefix)
+		(in.ExDividendDate).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"payoutRatio\":"
+		out.RawString(prefix)
+		(in.PayoutRatio).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"fiveYearAvgDividendYield\":"
+		out.RawString(prefix)
+		(in.FiveYearAvgDividendYield).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"trailingAnnualDividendRate\":"
+		out.RawString(prefix)
+		(in.TrailingAnnualDividendRate).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"trailingAnnualDividendYield\":"
+		out.RawString(prefix)
+		(in.TrailingAnnualDividendYield).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SummaryDetail) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonF381ebcaEncodeGithubComZeteliasGoyfinance2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SummaryDetail) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonF381ebcaEncodeGithubComZeteliasGoyfinance2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SummaryDetail) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonF381ebcaDecodeGithubComZeteliasGoyfinance2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SummaryDetail) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonF381ebcaDecodeGithubComZeteliasGoyfinance2(l, v)
+}
+func easyjsonF381ebcaDecodeGithubComZeteliasGoyfinance3(in *jlexer.Lexer, out *QuoteSummary) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "assetProfile":
+			if in.IsNull() {
+				in.Skip()
+				out.AssetProfile = nil
+			} else {
+				if out.AssetProfile == nil {
+					out.AssetProfile = new(AssetProfile)
+				}
+				(*out.AssetProfile).UnmarshalEasyJSON(in)
+			}
+		case "summaryDetail":
+			if in.IsNull() {
+				in.Skip()
+				out.SummaryDetail = nil
+			} else {
+				if out.SummaryDetail == nil {
+					out.SummaryDetail = new(SummaryDetail)
+				}
+				(*out.SummaryDetail).UnmarshalEasyJSON(in)
+			}
+		case "defaultKeyStatistics":
+			if in.IsNull() {
+				in.Skip()
+				out.DefaultKeyStatistics = nil
+			} else {
+				if out.DefaultKeyStatistics == nil {
+					out.DefaultKeyStatistics = new(DefaultKeyStatistics)
+				}
+				(*out.DefaultKeyStatistics).UnmarshalEasyJSON(in)
+			}
+		case "financialData":
+			if in.IsNull() {
+				in.Skip()
+				out.FinancialData = nil
+			} else {
+				if out.FinancialData == nil {
+					out.FinancialData = new(FinancialData)
+				}
+				(*out.FinancialData).UnmarshalEasyJSON(in)
+			}
+		case "price":
+			if in.IsNull() {
+				in.Skip()
+				out.Price = nil
+			} else {
+				if out.Price == nil {
+					out.Price = new(SummaryPrice)
+				}
+				(*out.Price).UnmarshalEasyJSON(in)
+			}
+		case "incomeStatementHistory":
+			if in.IsNull() {
+				in.Skip()
+				out.IncomeStatementHistory = nil
+			} else {
+				if out.IncomeStatementHistory == nil {
+					out.IncomeStatementHistory = new(IncomeStatementHistory)
+				}
+				(*out.IncomeStatementHistory).UnmarshalEasyJSON(in)
+			}
+		case "incomeStatementHistoryQuarterly":
+			if in.IsNull() {
+				in.Skip()
+				out.IncomeStatementHistoryQuarterly = nil
+			} else {
+				if out.IncomeStatementHistoryQuarterly == nil {
+					out.IncomeStatementHistoryQuarterly = new(IncomeStatementHistory)
+				}
+				(*out.IncomeStatementHistoryQuarterly).UnmarshalEasyJSON(in)
+			}
+		case "balanceSheetHistory":
+			if in.IsNull() {
+				in.Skip()
+				out.BalanceSheetHistory = nil
+			} else {
+				if out.BalanceSheetHistory == nil {
+					out.BalanceSheetHistory = new(BalanceSheetHistory)
+				}
+				(*out.BalanceSheetHistory).UnmarshalEasyJSON(in)
+			}
+		case "balanceSheetHistoryQuarterly":
+			if in.IsNull() {
+				in.Skip()
+				out.BalanceSheetHistoryQuarterly = nil
+			} else {
+				if out.BalanceSheetHistoryQuarterly == nil {
+					out.BalanceSheetHistoryQuarterly = new(BalanceSheetHistory)
+				}
+				(*out.BalanceSheetHistoryQuarterly).UnmarshalEasyJSON(in)
+			}
+		case "cashflowStatementHistory":
+			if in.IsNull() {
+				in.Skip()
+				out.CashflowStatementHistory = nil
+			} else {
+				if out.CashflowStatementHistory == nil {
+					out.CashflowStatementHistory = new(CashflowStatementHistory)
+				}
+				(*out.CashflowStatementHistory).UnmarshalEasyJSON(in)
+			}
+		case "cashflowStatementHistoryQuarterly":
+			if in.IsNull() {
+				in.Skip()
+				out.CashflowStatementHistoryQuarterly = nil
+			} else {
+				if out.CashflowStatementHistoryQuarterly == nil {
+					out.CashflowStatementHistoryQuarterly = new(CashflowStatementHistory)
+				}
+				(*out.CashflowStatementHistoryQuarterly).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonF381ebcaEncodeGithubComZeteliasGoyfinance3(out *jwriter.Writer, in QuoteSummary) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"assetProfile\":"
+		out.RawString(prefix[1:])
+		if in.AssetProfile == nil {
+			out.RawString("null")
+		} else {
+			(*in.AssetProfile).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"summaryDetail\":"
+		out.RawString(prefix)
+		if in.SummaryDetail == nil {
+			out.RawString("null")
+		} else {
+			(*in.SummaryDetail).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"defaultKeyStatistics\":"
+		out.RawString(prefix)
+		if in.DefaultKeyStatistics == nil {
+			out.RawString("null")
+		} else {
+			(*in.DefaultKeyStatistics).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"financialData\":"
+		out.RawString(prefix)
+		if in.FinancialData == nil {
+			out.RawString("null")
+		} else {
+			(*in.FinancialData).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"price\":"
+		out.RawString(prefix)
+		if in.Price == nil {
+			out.RawString("null")
+		} else {
+			(*in.Price).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"incomeStatementHistory\":"
+		out.RawString(prefix)
+		if in.IncomeStatementHistory == nil {
+			out.RawString("null")
+		} else {
+			(*in.IncomeStatementHistory).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"incomeStatementHistoryQuarterly\":"
+		out.RawString(prefix)
+		if in.IncomeStatementHistoryQuarterly == nil {
+			out.RawString("null")
+		} else {
+			(*in.IncomeStatementHistoryQuarterly).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"balanceSheetHistory\":"
+		out.RawString(prefix)
+		if in.BalanceSheetHistory == nil {
+			out.RawString("null")
+		} else {
+			(*in.BalanceSheetHistory).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"balanceSheetHistoryQuarterly\":"
+		out.RawString(prefix)
+		if in.BalanceSheetHistoryQuarterly == nil {
+			out.RawString("null")
+		} else {
+			(*in.BalanceSheetHistoryQuarterly).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"cashflowStatementHistory\":"
+		out.RawString(prefix)
+		if in.CashflowStatementHistory == nil {
+			out.RawString("null")
+		} else {
+			(*in.CashflowStatementHistory).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"cashflowStatementHistoryQuarterly\":"
+		out.RawString(prefix)
+		if in.CashflowStatementHistoryQuarterly == nil {
+			out.RawString("null")
+		} else {
+			(*in.CashflowStatementHistoryQuarterly).MarshalEasyJSON(out)
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v QuoteSummary) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonF381ebcaEncodeGithubComZeteliasGoyfinance3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v QuoteSummary) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonF381ebcaEncodeGithubComZeteliasGoyfinance3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *QuoteSummary) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonF381ebcaDecodeGithubComZeteliasGoyfinance3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *QuoteSummary) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonF381ebcaDecodeGithubComZeteliasGoyfinance3(l, v)
+}
+func easyjsonF381ebcaDecodeGithubComZeteliasGoyfinance4(in *jlexer.Lexer, out *FinancialData) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "financialCurrency":
+			out.FinancialCurrency = string(in.String())
+		case "currentPrice":
+			(out.CurrentPrice).UnmarshalEasyJSON(in)
+		case "targetLowPrice":
+			(out.TargetLowPrice).UnmarshalEasyJSON(in)
+		case "targetHighPrice":
+			(out.TargetHighPrice).UnmarshalEasyJSON(in)
+		case "targetMeanPrice":
+			(out.TargetMeanPrice).UnmarshalEasyJSON(in)
+		case "targetMedianPrice":
+			(out.TargetMedianPrice).UnmarshalEasyJSON(in)
+		case "recommendationMean":
+			(out.RecommendationMean).UnmarshalEasyJSON(in)
+		case "recommendationKey":
+			out.RecommendationKey = string(in.String())
+		case "numberOfAnalystOpinions":
+			(out.NumberOfAnalystOpinions).UnmarshalEasyJSON(in)
+		case "totalRevenue":
+			(out.TotalRevenue).UnmarshalEasyJSON(in)
+		case "revenuePerShare":
+			(out.RevenuePerShare).UnmarshalEasyJSON(in)
+		case "revenueGrowth":
+			(out.RevenueGrowth).UnmarshalEasyJSON(in)
+		case "grossProfits":
+			(out.GrossProfits).UnmarshalEasyJSON(in)
+		case "ebitda":
+			(out.Ebitda).UnmarshalEasyJSON(in)
+		case "earningsGrowth":
+			(out.EarningsGrowth).UnmarshalEasyJSON(in)
+		case "grossMargins":
+			(out.GrossMargins).UnmarshalEasyJSON(in)
+		case "ebitdaMargins":
+			(out.EbitdaMargins).UnmarshalEasyJSON(in)
+		case "operatingMargins":
+			(out.OperatingMargins).UnmarshalEasyJSON(in)
+		case "profitMargins":
+			(out.ProfitMargins).UnmarshalEasyJSON(in)
+		case "returnOnAssets":
+			(out.ReturnOnAssets).UnmarshalEasyJSON(in)
+		case "returnOnEquity":
+			(out.ReturnOnEquity).UnmarshalEasyJSON(in)
+		case "totalCash":
+			(out.TotalCash).UnmarshalEasyJSON(in)
+		case "totalCashPerShare":
+			(out.TotalCashPerShare).UnmarshalEasyJSON(in)
+		case "totalDebt":
+			(out.TotalDebt).UnmarshalEasyJSON(in)
+		case "debtToEquity":
+			(out.DebtToEquity).UnmarshalEasyJSON(in)
+		case "quickRatio":
+			(out.QuickRatio).UnmarshalEasyJSON(in)
+		case "currentRatio":
+			(out.CurrentRatio).UnmarshalEasyJSON(in)
+		case "operatingCashflow":
+			(out.OperatingCashflow).UnmarshalEasyJSON(in)
+		case "freeCashflow":
+			(out.FreeCashflow).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonF381ebcaEncodeGithubComZeteliasGoyfinance4(out *jwriter.Writer, in FinancialData) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"financialCurrency\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.FinancialCurrency))
+	}
+	{
+		const prefix string = ",\"currentPrice\":"
+		out.RawString(prefix)
+		(in.CurrentPrice).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"targetLowPrice\":"
+		out.RawString(prefix)
+		(in.TargetLowPrice).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"targetHighPrice\":"
+		out.RawString(prefix)
+		(in.TargetHighPrice).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"targetMeanPrice\":"
+		out.RawString(prefix)
+		(in.TargetMeanPrice).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"targetMedianPrice\":"
+		out.RawString(prefix)
+		(in.TargetMedianPrice).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"recommendationMean\":"
+		out.RawString(prefix)
+		(in.RecommendationMean).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"recommendationKey\":"
+		out.RawString(prefix)
+		out.String(string(in.RecommendationKey))
+	}
+	{
+		const prefix string = ",\"numberOfAnalystOpinions\":"
+		out.RawString(prefix)
+		(in.NumberOfAnalystOpinions).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"totalRevenue\":"
+		out.RawString(prefix)
+		(in.TotalRevenue).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"revenuePerShare\":"
+		out.RawString(prefix)
+		(in.RevenuePerShare).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"revenueGrowth\":"
+		out.RawString(prefix)
+		(in.RevenueGrowth).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"grossProfits\":"
+		out.RawString(prefix)
+		(in.GrossProfits).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"ebitda\":"
+		out.RawString(prefix)
+		(in.Ebitda).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"earningsGrowth\":"
+		out.RawString(prefix)
+		(in.EarningsGrowth).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"grossMargins\":"
+		out.RawString(prefix)
+		(in.GrossMargins).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"ebitdaMargins\":"
+		out.RawString(prefix)
+		(in.EbitdaMargins).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"operatingMargins\":"
+		out.RawString(prefix)
+		(in.OperatingMargins).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"profitMargins\":"
+		out.RawString(prefix)
+		(in.ProfitMargins).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"returnOnAssets\":"
+		out.RawString(prefix)
+		(in.ReturnOnAssets).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"returnOnEquity\":"
+		out.RawString(prefix)
+		(in.ReturnOnEquity).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"totalCash\":"
+		out.RawString(prefix)
+		(in.TotalCash).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"totalCashPerShare\":"
+		out.RawString(prefix)
+		(in.TotalCashPerShare).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"totalDebt\":"
+		out.RawString(prefix)
+		(in.TotalDebt).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"debtToEquity\":"
+		out.RawString(prefix)
+		(in.DebtToEquity).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"quickRatio\":"
+		out.RawString(prefix)
+		(in.QuickRatio).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"currentRatio\":"
+		out.RawString(prefix)
+		(in.CurrentRatio).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"operatingCashflow\":"
+		out.RawString(prefix)
+		(in.OperatingCashflow).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"freeCashflow\":"
+		out.RawString(prefix)
+		(in.FreeCashflow).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v FinancialData) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonF381ebcaEncodeGithubComZeteliasGoyfinance4(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v FinancialData) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonF381ebcaEncodeGithubComZeteliasGoyfinance4(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *FinancialData) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonF381ebcaDecodeGithubComZeteliasGoyfinance4(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *FinancialData) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonF381ebcaDecodeGithubComZeteliasGoyfinance4(l, v)
+}
+func easyjsonF381ebcaDecodeGithubComZeteliasGoyfinance5(in *jlexer.Lexer, out *DefaultKeyStatistics) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "enterpriseValue":
+			(out.EnterpriseValue).UnmarshalEasyJSON(in)
+		case "enterpriseToRevenue":
+			(out.EnterpriseToRevenue).UnmarshalEasyJSON(in)
+		case "enterpriseToEbitda":
+			(out.EnterpriseToEbitda).UnmarshalEasyJSON(in)
+		case "forwardPE":
+			(out.ForwardPE).UnmarshalEasyJSON(in)
+		case "pegRatio":
+			(out.PegRatio).UnmarshalEasyJSON(in)
+		case "priceToBook":
+			(out.PriceToBook).UnmarshalEasyJSON(in)
+		case "bookValue":
+			(out.BookValue).UnmarshalEasyJSON(in)
+		case "profitMargins":
+			(out.ProfitMargins).UnmarshalEasyJSON(in)
+		case "trailingEps":
+			(out.TrailingEps).UnmarshalEasyJSON(in)
+		case "forwardEps":
+			(out.ForwardEps).UnmarshalEasyJSON(in)
+		case "netIncomeToCommon":
+			(out.NetIncomeToCommon).UnmarshalEasyJSON(in)
+		case "earningsQuarterlyGrowth":
+			(out.EarningsQuarterlyGrowth).UnmarshalEasyJSON(in)
+		case "sharesOutstanding":
+			(out.SharesOutstanding).UnmarshalEasyJSON(in)
+		case "floatShares":
+			(out.FloatShares).UnmarshalEasyJSON(in)
+		case "sharesShort":
+			(out.SharesShort).UnmarshalEasyJSON(in)
+		case "shortRatio":
+			(out.ShortRatio).UnmarshalEasyJSON(in)
+		case "shortPercentOfFloat":
+			(out.ShortPercentOfFloat).UnmarshalEasyJSON(in)
+		case "heldPercentInsiders":
+			(out.HeldPercentInsiders).UnmarshalEasyJSON(in)
+		case "heldPercentInstitutions":
+			(out.HeldPercentInstitutions).UnmarshalEasyJSON(in)
+		case "beta":
+			(out.Beta).UnmarshalEasyJSON(in)
+		case "52WeekChange":
+			(out.FiftyTwoWeekChange).UnmarshalEasyJSON(in)
+		case "SandP52WeekChange":
+			(out.SandP52WeekChange).UnmarshalEasyJSON(in)
+		case "lastFiscalYearEnd":
+			(out.LastFiscalYearEnd).UnmarshalEasyJSON(in)
+		case "nextFiscalYearEnd":
+			(out.NextFiscalYearEnd).UnmarshalEasyJSON(in)
+		case "mostRecentQuarter":
+			(out.MostRecentQuarter).UnmarshalEasyJSON(in)
+		case "lastSplitFactor":
+			out.LastSplitFactor = string(in.String())
+		case "lastSplitDate":
+			(out.LastSplitDate).UnmarshalEasyJSON(in)
+		case "lastDividendValue":
+			(out.LastDividendValue).UnmarshalEasyJSON(in)
+		case "lastDividendDate":
+			(out.LastDividendDate).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonF381ebcaEncodeGithubComZeteliasGoyfinance5(out *jwriter.Writer, in DefaultKeyStatistics) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"enterpriseValue\":"
+		out.RawString(prefix[1:])
+		(in.EnterpriseValue).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"enterpriseToRevenue\":"
+		out.RawString(prefix)
+		(in.EnterpriseToRevenue).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"enterpriseToEbitda\":"
+		out.RawString(prefix)
+		(in.EnterpriseToEbitda).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"forwardPE\":"
+		out.RawString(prefix)
+		(in.ForwardPE).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"pegRatio\":"
+		out.RawString(prefix)
+		(in.PegRatio).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"priceToBook\":"
+		out.RawString(prefix)
+		(in.PriceToBook).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"bookValue\":"
+		out.RawString(prefix)
+		(in.BookValue).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"profitMargins\":"
+		out.RawString(prefix)
+		(in.ProfitMargins).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"trailingEps\":"
+		out.RawString(prefix)
+		(in.TrailingEps).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"forwardEps\":"
+		out.RawString(prefix)
+		(in.ForwardEps).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"netIncomeToCommon\":"
+		out.RawString(prefix)
+		(in.NetIncomeToCommon).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"earningsQuarterlyGrowth\":"
+		out.RawString(prefix)
+		(in.EarningsQuarterlyGrowth).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"sharesOutstanding\":"
+		out.RawString(prefix)
+		(in.SharesOutstanding).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"floatShares\":"
+		out.RawString(prefix)
+		(in.FloatShares).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"sharesShort\":"
+		out.RawString(prefix)
+		(in.SharesShort).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"shortRatio\":"
+		out.RawString(prefix)
+		(in.ShortRatio).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"shortPercentOfFloat\":"
+		out.RawString(prefix)
+		(in.ShortPercentOfFloat).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"heldPercentInsiders\":"
+		out.RawString(prefix)
+		(in.HeldPercentInsiders).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"heldPercentInstitutions\":"
+		out.RawString(prefix)
+		(in.HeldPercentInstitutions).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"beta\":"
+		out.RawString(prefix)
+		(in.Beta).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"52WeekChange\":"
+		out.RawString(prefix)
+		(in.FiftyTwoWeekChange).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"SandP52WeekChange\":"
+		out.RawString(prefix)
+		(in.SandP52WeekChange).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"lastFiscalYearEnd\":"
+		out.RawString(prefix)
+		(in.LastFiscalYearEnd).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"nextFiscalYearEnd\":"
+		out.RawString(prefix)
+		(in.NextFiscalYearEnd).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"mostRecentQuarter\":"
+		out.RawString(prefix)
+		(in.MostRecentQuarter).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"lastSplitFactor\":"
+		out.RawString(prefix)
+		out.String(string(in.LastSplitFactor))
+	}
+	{
+		const prefix string = ",\"lastSplitDate\":"
+		out.RawString(prefix)
+		(in.LastSplitDate).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"lastDividendValue\":"
+		out.RawString(prefix)
+		(in.LastDividendValue).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"lastDividendDate\":"
+		out.RawString(prefix)
+		(in.LastDividendDate).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v DefaultKeyStatistics) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonF381ebcaEncodeGithubComZeteliasGoyfinance5(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v DefaultKeyStatistics) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonF381ebcaEncodeGithubComZeteliasGoyfinance5(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *DefaultKeyStatistics) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonF381ebcaDecodeGithubComZeteliasGoyfinance5(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *DefaultKeyStatistics) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonF381ebcaDecodeGithubComZeteliasGoyfinance5(l, v)
+}
+func easyjsonF381ebcaDecodeGithubComZeteliasGoyfinance6(in *jlexer.Lexer, out *CompanyOfficer) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "name":
+			out.Name = string(in.String())
+		case "title":
+			out.Title = string(in.String())
+		case "age":
+			out.Age = int(in.Int())
+		case "yearBorn":
+			out.YearBorn = int(in.Int())
+		case "totalPay":
+			(out.TotalPay).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonF381ebcaEncodeGithubComZeteliasGoyfinance6(out *jwriter.Writer, in CompanyOfficer) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"age\":"
+		out.RawString(prefix)
+		out.Int(int(in.Age))
+	}
+	{
+		const prefix string = ",\"yearBorn\":"
+		out.RawString(prefix)
+		out.Int(int(in.YearBorn))
+	}
+	{
+		const prefix string = ",\"totalPay\":"
+		out.RawString(prefix)
+		(in.TotalPay).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CompanyOfficer) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonF381ebcaEncodeGithubComZeteliasGoyfinance6(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CompanyOfficer) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonF381ebcaEncodeGithubComZeteliasGoyfinance6(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CompanyOfficer) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonF381ebcaDecodeGithubComZeteliasGoyfinance6(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CompanyOfficer) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonF381ebcaDecodeGithubComZeteliasGoyfinance6(l, v)
+}
+func easyjsonF381ebcaDecodeGithubComZeteliasGoyfinance7(in *jlexer.Lexer, out *AssetProfile) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "address1":
+			out.Address1 = string(in.String())
+		case "city":
+			out.City = string(in.String())
+		case "state":
+			out.State = string(in.String())
+		case "zip":
+			out.Zip = string(in.String())
+		case "country":
+			out.Country = string(in.String())
+		case "phone":
+			out.Phone = string(in.String())
+		case "website":
+			out.Website = string(in.String())
+		case "industry":
+			out.Industry = string(in.String())
+		case "industryKey":
+			out.IndustryKey = string(in.String())
+		case "sector":
+			out.Sector = string(in.String())
+		case "sectorKey":
+			out.SectorKey = string(in.String())
+		case "longBusinessSummary":
+			out.LongBusinessSummary = string(in.String())
+		case "fullTimeEmployees":
+			out.FullTimeEmployees = int64(in.Int64())
+		case "companyOfficers":
+			if in.IsNull() {
+				in.Skip()
+				out.CompanyOfficers = nil
+			} else {
+				in.Delim('[')
+				if out.CompanyOfficers == nil {
+					if !in.IsDelim(']') {
+						out.CompanyOfficers = make([]CompanyOfficer, 0, 0)
+					} else {
+						out.CompanyOfficers = []CompanyOfficer{}
+					}
+				} else {
+					out.CompanyOfficers = (out.CompanyOfficers)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v4 CompanyOfficer
+					(v4).UnmarshalEasyJSON(in)
+					out.CompanyOfficers = append(out.CompanyOfficers, v4)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "auditRisk":
+			out.AuditRisk = int(in.Int())
+		case "boardRisk":
+			out.BoardRisk = int(in.Int())
+		case "compensationRisk":
+			out.CompensationRisk = int(in.Int())
+		case "shareHolderRightsRisk":
+			out.ShareHolderRightsRisk = int(in.Int())
+		case "overallRisk":
+			out.OverallRisk = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonF381ebcaEncodeGithubComZeteliasGoyfinance7(out *jwriter.Writer, in AssetProfile) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"address1\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Address1))
+	}
+	{
+		const prefix string = ",\"city\":"
+		out.RawString(prefix)
+		out.String(string(in.City))
+	}
+	{
+		const prefix string = ",\"state\":"
+		out.RawString(prefix)
+		out.String(string(in.State))
+	}
+	{
+		const prefix string = ",\"zip\":"
+		out.RawString(prefix)
+		out.String(string(in.Zip))
+	}
+	{
+		const prefix string = ",\"country\":"
+		out.RawString(prefix)
+		out.String(string(in.Country))
+	}
+	{
+		const prefix string = ",\"phone\":"
+		out.RawString(prefix)
+		out.String(string(in.Phone))
+	}
+	{
+		const prefix string = ",\"website\":"
+		out.RawString(prefix)
+		out.String(string(in.Website))
+	}
+	{
+		const prefix string = ",\"industry\":"
+		out.RawString(prefix)
+		out.String(string(in.Industry))
+	}
+	{
+		const prefix string = ",\"industryKey\":"
+		out.RawString(prefix)
+		out.String(string(in.IndustryKey))
+	}
+	{
+		const prefix string = ",\"sector\":"
+		out.RawString(prefix)
+		out.String(string(in.Sector))
+	}
+	{
+		const prefix string = ",\"sectorKey\":"
+		out.RawString(prefix)
+		out.String(string(in.SectorKey))
+	}
+	{
+		const prefix string = ",\"longBusinessSummary\":"
+		out.RawString(prefix)
+		out.String(string(in.LongBusinessSummary))
+	}
+	{
+		const prefix string = ",\"fullTimeEmployees\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.FullTimeEmployees))
+	}
+	{
+		const prefix string = ",\"companyOfficers\":"
+		out.RawString(prefix)
+		if in.CompanyOfficers == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v5, v6 := range in.CompanyOfficers {
+				if v5 > 0 {
+					out.RawByte(',')
+				}
+				(v6).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"auditRisk\":"
+		out.RawString(prefix)
+		out.Int(int(in.AuditRisk))
+	}
+	{
+		const prefix string = ",\"boardRisk\":"
+		out.RawString(prefix)
+		out.Int(int(in.BoardRisk))
+	}
+	{
+		const prefix string = ",\"compensationRisk\":"
+		out.RawString(prefix)
+		out.Int(int(in.CompensationRisk))
+	}
+	{
+		const prefix string = ",\"shareHolderRightsRisk\":"
+		out.RawString(prefix)
+		out.Int(int(in.ShareHolderRightsRisk))
+	}
+	{
+		const prefix string = ",\"overallRisk\":"
+		out.RawString(prefix)
+		out.Int(int(in.OverallRisk))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v AssetProfile) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonF381ebcaEncodeGithubComZeteliasGoyfinance7(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v AssetProfile) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonF381ebcaEncodeGithubComZeteliasGoyfinance7(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *AssetProfile) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonF381ebcaDecodeGithubComZeteliasGoyfinance7(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *AssetProfile) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonF381ebcaDecodeGithubComZeteliasGoyfinance7(l, v)
+}
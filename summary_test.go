@@ -0,0 +1,87 @@
+package goyfinance
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/mailru/easyjson"
+	"github.com/valyala/fasthttp"
+	"testing"
+)
+
+func TestGetQuoteSummary(t *testing.T) {
+	var gotModules, gotCrumb string
+	client := newTestClient(t, func(ctx *fasthttp.RequestCtx) {
+		switch string(ctx.Path()) {
+		case "/v1/test/getcrumb":
+			ctx.SetBodyString("crumb")
+		case "/v10/finance/quoteSummary/AAPL":
+			gotModules = string(ctx.QueryArgs().Peek("modules"))
+			gotCrumb = string(ctx.QueryArgs().Peek("crumb"))
+			ctx.SetBodyString(`{"quoteSummary":{"result":[{` +
+				`"assetProfile":{"sector":"Technology","fullTimeEmployees":161000,"companyOfficers":[{"name":"Mr. Timothy D. Cook","title":"CEO & Director","age":62,"totalPay":{"raw":16425933,"fmt":"16.43M","longFmt":"16,425,933"}}],"overallRisk":1},` +
+				`"summaryDetail":{"currency":"USD","trailingPE":{"raw":29.61,"fmt":"29.61"},"forwardPE":{},"exDividendDate":{"raw":1707436800,"fmt":"2024-02-09"},"marketCap":{"raw":2.8e12,"fmt":"2.8T","longFmt":"2,800,000,000,000"}},` +
+				`"defaultKeyStatistics":{"52WeekChange":{"raw":0.31,"fmt":"31.00%"},"lastSplitFactor":"4:1","pegRatio":null},` +
+				`"financialData":{"recommendationKey":"buy","totalDebt":{"raw":108040003584,"fmt":"108.04B","longFmt":"108,040,003,584"}},` +
+				`"price":{"symbol":"AAPL","marketState":"POSTPOST","regularMarketTime":1704488400,"regularMarketPrice":{"raw":181.18,"fmt":"181.18"},"maxAge":1}` +
+				`}],"error":null}}`)
+		default:
+			ctx.SetStatusCode(fasthttp.StatusNotFound)
+			ctx.SetBodyString(`{"quoteSummary":{"result":null,"error":{"code":"Not Found","description":"Quote not found for ticker symbol: NOPE"}}}`)
+		}
+	})
+
+	summary, err := client.GetQuoteSummary(context.Background(), "AAPL")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if gotModules != "assetProfile,summaryDetail,defaultKeyStatistics,financialData,price" || gotCrumb != "crumb" {
+		t.Errorf("sent modules=%q and crumb=%q", gotModules, gotCrumb)
+	}
+
+	profile := summary.AssetProfile
+	if profile == nil || profile.Sector != "Technology" || profile.FullTimeEmployees != 161000 || len(profile.CompanyOfficers) != 1 ||
+		profile.CompanyOfficers[0].TotalPay.Raw != 16425933 || profile.CompanyOfficers[0].TotalPay.LongFmt != "16,425,933" {
+		t.Errorf("unexpected asset profile %+v", profile)
+	}
+	detail := summary.SummaryDetail
+	if detail == nil || detail.TrailingPE.Raw != 29.61 || detail.TrailingPE.Fmt != "29.61" || detail.ForwardPE != (FormattedValue{}) ||
+		detail.MarketCap.Raw != 2.8e12 || detail.ExDividendDate.Time().Format("2006-01-02") != "2024-02-09" {
+		t.Errorf("unexpected summary detail %+v", detail)
+	}
+	statistics := summary.DefaultKeyStatistics
+	if statistics == nil || statistics.FiftyTwoWeekChange.Raw != 0.31 || statistics.LastSplitFactor != "4:1" || !statistics.LastSplitDate.Time().IsZero() {
+		t.Errorf("unexpected key statistics %+v", statistics)
+	}
+	if summary.FinancialData == nil || summary.FinancialData.RecommendationKey != "buy" || summary.FinancialData.TotalDebt.Raw != 108040003584 {
+		t.Errorf("unexpected financial data %+v", summary.FinancialData)
+	}
+	if summary.Price == nil || summary.Price.RegularMarketPrice.Raw != 181.18 || summary.Price.RegularMarketTime != 1704488400 {
+		t.Errorf("unexpected price %+v", summary.Price)
+	}
+
+	_, err = client.GetQuoteSummary(context.Background(), "NOPE", ModulePrice)
+	if !errors.Is(err, ErrTickerNotFound) {
+		t.Errorf("expected ErrTickerNotFound, got %v", err)
+	}
+}
+
+func TestFormattedValue(t *testing.T) {
+	var values struct {
+		Object FormattedValue `json:"object"`
+		Number FormattedValue `json:"number"`
+		Empty  FormattedValue `json:"empty"`
+	}
+	err := json.Unmarshal([]byte(`{"object":{"raw":0.0512,"fmt":"5.12%"},"number":42.5,"empty":{}}`), &values)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if values.Object.Raw != 0.0512 || values.Object.Fmt != "5.12%" || values.Number.Raw != 42.5 || values.Empty != (FormattedValue{}) {
+		t.Errorf("unexpected values %+v", values)
+	}
+
+	data, err := easyjson.Marshal(values.Object)
+	if err != nil || string(data) != `{"raw":0.0512,"fmt":"5.12%"}` {
+		t.Errorf("marshaled as %s, error %v", data, err)
+	}
+}
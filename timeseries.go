@@ -0,0 +1,307 @@
+package goyfinance
+
+import (
+	"context"
+	"fmt"
+	"github.com/mailru/easyjson"
+	"github.com/mailru/easyjson/jlexer"
+	"github.com/mailru/easyjson/jwriter"
+	"github.com/valyala/fasthttp"
+	"sort"
+	"strings"
+	"time"
+)
+
+// TimeseriesValue is a value of the fundamentals-timeseries endpoint,
+// like the total revenue of a fiscal year.
+type TimeseriesValue struct {
+	AsOfDate     time.Time // End of the fiscal period, at midnight UTC
+	PeriodType   string    // For example "12M", "3M" or "TTM"
+	CurrencyCode string
+	Value        FormattedValue
+}
+
+// jsonTimeseriesResponse is the response of the fundamentals-timeseries endpoint.
+type jsonTimeseriesResponse struct {
+	Timeseries struct {
+		Result []jsonTimeseriesResult `json:"result"`
+		Error  *JSONError             `json:"error"`
+	} `json:"timeseries"`
+}
+
+// jsonTimeseriesMeta is the metadata of a series of the fundamentals-timeseries endpoint.
+type jsonTimeseriesMeta struct {
+	Symbol []string `json:"symbol"`
+	Type   []string `json:"type"`
+}
+
+// jsonTimeseriesPoint is a value of a series of the fundamentals-timeseries endpoint.
+type jsonTimeseriesPoint struct {
+	AsOfDate      string         `json:"asOfDate"` // For example "2023-09-30"
+	PeriodType    string         `json:"periodType"`
+	CurrencyCode  string         `json:"currencyCode"`
+	ReportedValue FormattedValue `json:"reportedValue"`
+}
+
+// jsonTimeseriesResult is a series of the fundamentals-timeseries endpoint.
+// Yahoo sends its values under the name of its type, like "annualTotalRevenue",
+// and null for the periods without a value, so it is decoded by hand.
+//
+//easyjson:skip
+type jsonTimeseriesResult struct {
+	Meta   jsonTimeseriesMeta
+	Type   string
+	Points []jsonTimeseriesPoint
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *jsonTimeseriesResult) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	if in.IsNull() {
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch {
+		case key == "meta":
+			v.Meta.UnmarshalEasyJSON(in)
+		case key == "timestamp" || in.IsNull() || !in.IsDelim('['):
+			in.SkipRecursive()
+		default:
+			v.Type = key
+			in.Delim('[')
+			for !in.IsDelim(']') {
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					var point jsonTimeseriesPoint
+					point.UnmarshalEasyJSON(in)
+					v.Points = append(v.Points, point)
+				}
+				in.WantComma()
+			}
+			in.Delim(']')
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if v.Type == "" && len(v.Meta.Type) > 0 {
+		v.Type = v.Meta.Type[0]
+	}
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v jsonTimeseriesResult) MarshalEasyJSON(out *jwriter.Writer) {
+	out.RawString(`{"meta":`)
+	v.Meta.MarshalEasyJSON(out)
+	if v.Type != "" {
+		out.RawByte(',')
+		out.String(v.Type)
+		out.RawString(`:[`)
+		for i, point := range v.Points {
+			if i > 0 {
+				out.RawByte(',')
+			}
+			point.MarshalEasyJSON(out)
+		}
+		out.RawByte(']')
+	}
+	out.RawByte('}')
+}
+
+// timeseriesField is a field of a statement and the name of its series,
+// without the "annual" or "quarterly" prefix.
+//
+//easyjson:skip
+type timeseriesField[T any] struct {
+	name  string
+	field func(*T) *FormattedValue
+}
+
+var incomeStatementFields = []timeseriesField[IncomeStatement]{
+	{"TotalRevenue", func(s *IncomeStatement) *FormattedValue { return &s.TotalRevenue }},
+	{"CostOfRevenue", func(s *IncomeStatement) *FormattedValue { return &s.CostOfRevenue }},
+	{"GrossProfit", func(s *IncomeStatement) *FormattedValue { return &s.GrossProfit }},
+	{"ResearchAndDevelopment", func(s *IncomeStatement) *FormattedValue { return &s.ResearchDevelopment }},
+	{"SellingGeneralAndAdministration", func(s *IncomeStatement) *FormattedValue { return &s.SellingGeneralAdministrative }},
+	{"OperatingIncome", func(s *IncomeStatement) *FormattedValue { return &s.OperatingIncome }},
+	{"InterestExpense", func(s *IncomeStatement) *FormattedValue { return &s.InterestExpense }},
+	{"PretaxIncome", func(s *IncomeStatement) *FormattedValue { return &s.IncomeBeforeTax }},
+	{"TaxProvision", func(s *IncomeStatement) *FormattedValue { return &s.IncomeTaxExpense }},
+	{"NetIncome", func(s *IncomeStatement) *FormattedValue { return &s.NetIncome }},
+	{"EBIT", func(s *IncomeStatement) *FormattedValue { return &s.EBIT }},
+	{"EBITDA", func(s *IncomeStatement) *FormattedValue { return &s.EBITDA }},
+	{"BasicEPS", func(s *IncomeStatement) *FormattedValue { return &s.BasicEPS }},
+	{"DilutedEPS", func(s *IncomeStatement) *FormattedValue { return &s.DilutedEPS }},
+}
+
+var balanceSheetFields = []timeseriesField[BalanceSheet]{
+	{"CashAndCashEquivalents", func(s *BalanceSheet) *FormattedValue { return &s.Cash }},
+	{"OtherShortTermInvestments", func(s *BalanceSheet) *FormattedValue { return &s.ShortTermInvestments }},
+	{"Receivables", func(s *BalanceSheet) *FormattedValue { return &s.NetReceivables }},
+	{"Inventory", func(s *BalanceSheet) *FormattedValue { return &s.Inventory }},
+	{"CurrentAssets", func(s *BalanceSheet) *FormattedValue { return &s.TotalCurrentAssets }},
+	{"TotalAssets", func(s *BalanceSheet) *FormattedValue { return &s.TotalAssets }},
+	{"AccountsPayable", func(s *BalanceSheet) *FormattedValue { return &s.AccountsPayable }},
+	{"CurrentLiabilities", func(s *BalanceSheet) *FormattedValue { return &s.TotalCurrentLiabilities }},
+	{"LongTermDebt", func(s *BalanceSheet) *FormattedValue { return &s.LongTermDebt }},
+	{"TotalDebt", func(s *BalanceSheet) *FormattedValue { return &s.TotalDebt }},
+	{"TotalLiabilitiesNetMinorityInterest", func(s *BalanceSheet) *FormattedValue { return &s.TotalLiabilities }},
+	{"RetainedEarnings", func(s *BalanceSheet) *FormattedValue { return &s.RetainedEarnings }},
+	{"StockholdersEquity", func(s *BalanceSheet) *FormattedValue { return &s.TotalStockholderEquity }},
+	{"OrdinarySharesNumber", func(s *BalanceSheet) *FormattedValue { return &s.SharesOutstanding }},
+}
+
+var cashFlowFields = []timeseriesField[CashFlowStatement]{
+	{"NetIncomeFromContinuingOperations", func(s *CashFlowStatement) *FormattedValue { return &s.NetIncome }},
+	{"DepreciationAndAmortization", func(s *CashFlowStatement) *FormattedValue { return &s.Depreciation }},
+	{"OperatingCashFlow", func(s *CashFlowStatement) *FormattedValue { return &s.OperatingCashFlow }},
+	{"CapitalExpenditure", func(s *CashFlowStatement) *FormattedValue { return &s.CapitalExpenditures }},
+	{"InvestingCashFlow", func(s *CashFlowStatement) *FormattedValue { return &s.InvestingCashFlow }},
+	{"CashDividendsPaid", func(s *CashFlowStatement) *FormattedValue { return &s.DividendsPaid }},
+	{"RepurchaseOfCapitalStock", func(s *CashFlowStatement) *FormattedValue { return &s.RepurchaseOfStock }},
+	{"FinancingCashFlow", func(s *CashFlowStatement) *FormattedValue { return &s.FinancingCashFlow }},
+	{"ChangesInCash", func(s *CashFlowStatement) *FormattedValue { return &s.ChangeInCash }},
+	{"FreeCashFlow", func(s *CashFlowStatement) *FormattedValue { return &s.FreeCashFlow }},
+}
+
+// timeseriesTypes returns the names of the series of fields for frequency.
+func timeseriesTypes[T any](frequency StatementFrequency, fields []timeseriesField[T]) []string {
+	types := make([]string, len(fields))
+	for i, field := range fields {
+		types[i] = string(frequency) + field.name
+	}
+	return types
+}
+
+// statementsFromTimeseries returns one statement per fiscal period end found in the series,
+// in chronological order, with the fields the series have a value for.
+func statementsFromTimeseries[T any](series map[string][]TimeseriesValue, frequency StatementFrequency, fields []timeseriesField[T], endDate func(*T) *FormattedValue) []T {
+	byEndDate := make(map[int64]*T)
+	for _, field := range fields {
+		for _, value := range series[string(frequency)+field.name] {
+			statement, ok := byEndDate[value.AsOfDate.Unix()]
+			if !ok {
+				statement = new(T)
+				*endDate(statement) = FormattedValue{Raw: float64(value.AsOfDate.Unix()), Fmt: value.AsOfDate.Format(time.DateOnly)}
+				byEndDate[value.AsOfDate.Unix()] = statement
+			}
+			*field.field(statement) = value.Value
+		}
+	}
+
+	statements := make([]T, 0, len(byEndDate))
+	for _, statement := range byEndDate {
+		statements = append(statements, *statement)
+	}
+	sortStatements(statements, func(statement T) FormattedValue { return *endDate(&statement) })
+	return statements
+}
+
+// GetFundamentalsTimeseries returns the series of the fundamentals-timeseries endpoint
+// for a ticker during period, by type, each in chronological order.
+// A type is a field prefixed by its frequency, for example "annualTotalRevenue",
+// "quarterlyNetIncome" or "trailingDilutedEPS".
+// Types Yahoo has no value for during period are missing from the map.
+// If an error occurs, the map will be nil.
+func (c *Client) GetFundamentalsTimeseries(ctx context.Context, ticker string, period Range, types ...string) (map[string][]TimeseriesValue, error) {
+	period1, period2, err := getUnixTimestamps(period)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := fasthttp.AcquireResponse()
+	defer fasthttp.ReleaseResponse(resp)
+
+	err = c.fetch(ctx, fmt.Sprintf("/ws/fundamentals-timeseries/v1/finance/timeseries/%s?symbol=%s&type=%s&period1=%d&period2=%d",
+		ticker, ticker, strings.Join(types, ","), period1, period2), resp)
+	if err != nil {
+		return nil, err
+	}
+
+	var jsonResp jsonTimeseriesResponse
+	if err := easyjson.Unmarshal(resp.Body(), &jsonResp); err != nil {
+		return nil, err
+	}
+	if jsonErr := jsonResp.Timeseries.Error; jsonErr != nil {
+		return nil, &APIError{StatusCode: resp.StatusCode(), Code: jsonErr.Code, Description: jsonErr.Description}
+	}
+
+	series := make(map[string][]TimeseriesValue)
+	for _, result := range jsonResp.Timeseries.Result {
+		for _, point := range result.Points {
+			asOfDate, err := time.Parse(time.DateOnly, point.AsOfDate)
+			if err != nil {
+				return nil, fmt.Errorf("goyfinance: %s: invalid date %q in %s", ticker, point.AsOfDate, result.Type)
+			}
+			series[result.Type] = append(series[result.Type], TimeseriesValue{
+				AsOfDate:     asOfDate,
+				PeriodType:   point.PeriodType,
+				CurrencyCode: point.CurrencyCode,
+				Value:        point.ReportedValue,
+			})
+		}
+		sort.SliceStable(series[result.Type], func(i, j int) bool {
+			return series[result.Type][i].AsOfDate.Before(series[result.Type][j].AsOfDate)
+		})
+	}
+	return series, nil
+}
+
+// GetFundamentalsTimeseries returns the series of the fundamentals-timeseries endpoint
+// for a ticker during period, by type, using the default client.
+// If an error occurs, the map will be nil.
+func GetFundamentalsTimeseries(ticker string, period Range, types ...string) (map[string][]TimeseriesValue, error) {
+	return GetFundamentalsTimeseriesCtx(context.Background(), ticker, period, types...)
+}
+
+// GetFundamentalsTimeseriesCtx is like GetFundamentalsTimeseries but takes a context
+// to cancel the request or set its deadline.
+func GetFundamentalsTimeseriesCtx(ctx context.Context, ticker string, period Range, types ...string) (map[string][]TimeseriesValue, error) {
+	return defaultClient.GetFundamentalsTimeseries(ctx, ticker, period, types...)
+}
+
+// GetFinancialStatementsTimeseries returns the financial statements of a ticker
+// for the fiscal periods ending during period, from the fundamentals-timeseries endpoint.
+// It serves longer histories than GetFinancialStatements, and every field of the statements.
+// If an error occurs, the FinancialStatements struct will be empty.
+func (c *Client) GetFinancialStatementsTimeseries(ctx context.Context, ticker string, frequency StatementFrequency, period Range) (FinancialStatements, error) {
+	if frequency != StatementsAnnual && frequency != StatementsQuarterly {
+		return FinancialStatements{}, fmt.Errorf("goyfinance: unknown statement frequency %q", string(frequency))
+	}
+
+	var types []string
+	types = append(types, timeseriesTypes(frequency, incomeStatementFields)...)
+	types = append(types, timeseriesTypes(frequency, balanceSheetFields)...)
+	types = append(types, timeseriesTypes(frequency, cashFlowFields)...)
+	series, err := c.GetFundamentalsTimeseries(ctx, ticker, period, types...)
+	if err != nil {
+		return FinancialStatements{}, err
+	}
+	if len(series) == 0 {
+		return FinancialStatements{}, fmt.Errorf("goyfinance: %s: %w", ticker, ErrNoData)
+	}
+
+	return FinancialStatements{
+		Ticker:        ticker,
+		Frequency:     frequency,
+		Income:        statementsFromTimeseries(series, frequency, incomeStatementFields, func(s *IncomeStatement) *FormattedValue { return &s.EndDate }),
+		BalanceSheets: statementsFromTimeseries(series, frequency, balanceSheetFields, func(s *BalanceSheet) *FormattedValue { return &s.EndDate }),
+		CashFlows:     statementsFromTimeseries(series, frequency, cashFlowFields, func(s *CashFlowStatement) *FormattedValue { return &s.EndDate }),
+	}, nil
+}
+
+// GetFinancialStatementsTimeseries returns the financial statements of a ticker
+// for the fiscal periods ending during period, using the default client.
+// If an error occurs, the FinancialStatements struct will be empty.
+func GetFinancialStatementsTimeseries(ticker string, frequency StatementFrequency, period Range) (FinancialStatements, error) {
+	return GetFinancialStatementsTimeseriesCtx(context.Background(), ticker, frequency, period)
+}
+
+// GetFinancialStatementsTimeseriesCtx is like GetFinancialStatementsTimeseries but takes a context
+// to cancel the request or set its deadline.
+func GetFinancialStatementsTimeseriesCtx(ctx context.Context, ticker string, frequency StatementFrequency, period Range) (FinancialStatements, error) {
+	return defaultClient.GetFinancialStatementsTimeseries(ctx, ticker, frequency, period)
+}
@@ -0,0 +1,497 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package goyfinance
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson3dd901c0DecodeGithubComZeteliasGoyfinance(in *jlexer.Lexer, out *jsonTimeseriesResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "timeseries":
+			easyjson3dd901c0Decode(in, &out.Timeseries)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson3dd901c0EncodeGithubComZeteliasGoyfinance(out *jwriter.Writer, in jsonTimeseriesResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"timeseries\":"
+		out.RawString(prefix[1:])
+		easyjson3dd901c0Encode(out, in.Timeseries)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v jsonTimeseriesResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson3dd901c0EncodeGithubComZeteliasGoyfinance(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v jsonTimeseriesResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson3dd901c0EncodeGithubComZeteliasGoyfinance(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *jsonTimeseriesResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson3dd901c0DecodeGithubComZeteliasGoyfinance(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *jsonTimeseriesResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson3dd901c0DecodeGithubComZeteliasGoyfinance(l, v)
+}
+func easyjson3dd901c0Decode(in *jlexer.Lexer, out *struct {
+	Result []jsonTimeseriesResult `json:"result"`
+	Error  *JSONError             `json:"error"`
+}) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "result":
+			if in.IsNull() {
+				in.Skip()
+				out.Result = nil
+			} else {
+				in.Delim('[')
+				if out.Result == nil {
+					if !in.IsDelim(']') {
+						out.Result = make([]jsonTimeseriesResult, 0, 0)
+					} else {
+						out.Result = []jsonTimeseriesResult{}
+					}
+				} else {
+					out.Result = (out.Result)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 jsonTimeseriesResult
+					(v1).UnmarshalEasyJSON(in)
+					out.Result = append(out.Result, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "error":
+			if in.IsNull() {
+				in.Skip()
+				out.Error = nil
+			} else {
+				if out.Error == nil {
+					out.Error = new(JSONError)
+				}
+				(*out.Error).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson3dd901c0Encode(out *jwriter.Writer, in struct {
+	Result []jsonTimeseriesResult `json:"result"`
+	Error  *JSONError             `json:"error"`
+}) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"result\":"
+		out.RawString(prefix[1:])
+		if in.Result == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v2, v3 := range in.Result {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				(v3).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"error\":"
+		out.RawString(prefix)
+		if in.Error == nil {
+			out.RawString("null")
+		} else {
+			(*in.Error).MarshalEasyJSON(out)
+		}
+	}
+	out.RawByte('}')
+}
+func easyjson3dd901c0DecodeGithubComZeteliasGoyfinance1(in *jlexer.Lexer, out *jsonTimeseriesPoint) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "asOfDate":
+			out.AsOfDate = string(in.String())
+		case "periodType":
+			out.PeriodType = string(in.String())
+		case "currencyCode":
+			out.CurrencyCode = string(in.String())
+		case "reportedValue":
+			(out.ReportedValue).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson3dd901c0EncodeGithubComZeteliasGoyfinance1(out *jwriter.Writer, in jsonTimeseriesPoint) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"asOfDate\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.AsOfDate))
+	}
+	{
+		const prefix string = ",\"periodType\":"
+		out.RawString(prefix)
+		out.String(string(in.PeriodType))
+	}
+	{
+		const prefix string = ",\"currencyCode\":"
+		out.RawString(prefix)
+		out.String(string(in.CurrencyCode))
+	}
+	{
+		const prefix string = ",\"reportedValue\":"
+		out.RawString(prefix)
+		(in.ReportedValue).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v jsonTimeseriesPoint) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson3dd901c0EncodeGithubComZeteliasGoyfinance1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v jsonTimeseriesPoint) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson3dd901c0EncodeGithubComZeteliasGoyfinance1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *jsonTimeseriesPoint) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson3dd901c0DecodeGithubComZeteliasGoyfinance1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *jsonTimeseriesPoint) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson3dd901c0DecodeGithubComZeteliasGoyfinance1(l, v)
+}
+func easyjson3dd901c0DecodeGithubComZeteliasGoyfinance2(in *jlexer.Lexer, out *jsonTimeseriesMeta) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "symbol":
+			if in.IsNull() {
+				in.Skip()
+				out.Symbol = nil
+			} else {
+				in.Delim('[')
+				if out.Symbol == nil {
+					if !in.IsDelim(']') {
+						out.Symbol = make([]string, 0, 4)
+					} else {
+						out.Symbol = []string{}
+					}
+				} else {
+					out.Symbol = (out.Symbol)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v4 string
+					v4 = string(in.String())
+					out.Symbol = append(out.Symbol, v4)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "type":
+			if in.IsNull() {
+				in.Skip()
+				out.Type = nil
+			} else {
+				in.Delim('[')
+				if out.Type == nil {
+					if !in.IsDelim(']') {
+						out.Type = make([]string, 0, 4)
+					} else {
+						out.Type = []string{}
+					}
+				} else {
+					out.Type = (out.Type)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v5 string
+					v5 = string(in.String())
+					out.Type = append(out.Type, v5)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson3dd901c0EncodeGithubComZeteliasGoyfinance2(out *jwriter.Writer, in jsonTimeseriesMeta) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"symbol\":"
+		out.RawString(prefix[1:])
+		if in.Symbol == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v6, v7 := range in.Symbol {
+				if v6 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v7))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		if in.Type == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v8, v9 := range in.Type {
+				if v8 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v9))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v jsonTimeseriesMeta) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson3dd901c0EncodeGithubComZeteliasGoyfinance2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v jsonTimeseriesMeta) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson3dd901c0EncodeGithubComZeteliasGoyfinance2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *jsonTimeseriesMeta) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson3dd901c0DecodeGithubComZeteliasGoyfinance2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *jsonTimeseriesMeta) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson3dd901c0DecodeGithubComZeteliasGoyfinance2(l, v)
+}
+func easyjson3dd901c0DecodeGithubComZeteliasGoyfinance3(in *jlexer.Lexer, out *TimeseriesValue) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "AsOfDate":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.AsOfDate).UnmarshalJSON(data))
+			}
+		case "PeriodType":
+			out.PeriodType = string(in.String())
+		case "CurrencyCode":
+			out.CurrencyCode = string(in.String())
+		case "Value":
+			(out.Value).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson3dd901c0EncodeGithubComZeteliasGoyfinance3(out *jwriter.Writer, in TimeseriesValue) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"AsOfDate\":"
+		out.RawString(prefix[1:])
+		out.Raw((in.AsOfDate).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"PeriodType\":"
+		out.RawString(prefix)
+		out.String(string(in.PeriodType))
+	}
+	{
+		const prefix string = ",\"CurrencyCode\":"
+		out.RawString(prefix)
+		out.String(string(in.CurrencyCode))
+	}
+	{
+		const prefix string = ",\"Value\":"
+		out.RawString(prefix)
+		(in.Value).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v TimeseriesValue) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson3dd901c0EncodeGithubComZeteliasGoyfinance3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v TimeseriesValue) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson3dd901c0EncodeGithubComZeteliasGoyfinance3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *TimeseriesValue) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson3dd901c0DecodeGithubComZeteliasGoyfinance3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *TimeseriesValue) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson3dd901c0DecodeGithubComZeteliasGoyfinance3(l, v)
+}
@@ -1,27 +1,80 @@
 package goyfinance
 
 import (
+	"context"
 	"fmt"
 	"github.com/valyala/fasthttp"
-	"sync"
 	"time"
 )
 
+// chartPath returns the path of the v8 chart endpoint for a ticker,
+// asking for dividends, splits and capital gains along with the prices,
+// and for extended-hours bars if includePrePost is true.
+// It also returns the unix timestamps of the start and the end of period,
+// which are 0 for a NativeRange since Yahoo computes them.
+func chartPath(ticker string, interval Interval, period Range, includePrePost bool) (string, int64, int64, error) {
+	query := "&events=div,split,capitalGains"
+	if includePrePost {
+		query += "&includePrePost=true"
+	}
+
+	if native, ok := period.(NativeRange); ok {
+		if _, _, err := getUnixTimestamps(native); err != nil {
+			return "", 0, 0, err
+		}
+		return fmt.Sprintf("/v8/finance/chart/%s?interval=%s&range=%s%s", ticker, interval, native, query), 0, 0, nil
+	}
+
+	period1, period2, err := getUnixTimestamps(period)
+	if err != nil {
+		return "", 0, 0, err
+	}
+	return fmt.Sprintf("/v8/finance/chart/%s?interval=%s&period1=%d&period2=%d%s", ticker, interval, period1, period2, query), period1, period2, nil
+}
+
+// downloadPath returns the path of the v7 CSV download endpoint for a ticker,
+// and the unix timestamps of the start and the end of period.
+func downloadPath(ticker string, interval Interval, period Range) (string, int64, int64, error) {
+	period1, period2, err := getUnixTimestamps(period)
+	if err != nil {
+		return "", 0, 0, err
+	}
+	return fmt.Sprintf("/v7/finance/download/%s?interval=%s&period1=%d&period2=%d&events=history", ticker, interval, period1, period2), period1, period2, nil
+}
+
+// fetch sends a GET request for path and fills resp with the response.
+// A response with a status code other than 200 OK is returned as an error.
+// resp must be acquired and released by the caller.
+func (c *Client) fetch(ctx context.Context, path string, resp *fasthttp.Response) error {
+	req := c.newRequest(path)
+	defer fasthttp.ReleaseRequest(req)
+
+	err := c.do(ctx, req, resp)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode() != fasthttp.StatusOK {
+		return parseErrorResponse(resp.StatusCode(), resp.Body())
+	}
+	return nil
+}
+
 // GetQuoteJSONString returns a JSON string from Yahoo Finance.
 // If an error occurs, the JSON string will be empty.
-func GetQuoteJSONString(ticker string, interval Interval, period Period) (string, error) {
-	req := fasthttp.AcquireRequest()
-	defer fasthttp.ReleaseRequest(req)
-	period1, period2 := getUnixTimestamps(period)
+func (c *Client) GetQuoteJSONString(ctx context.Context, ticker string, interval Interval, period Range) (string, error) {
+	if err := Validate(interval, period); err != nil {
+		return "", err
+	}
 
-	req.SetRequestURI(fmt.Sprintf("https://query1.finance.yahoo.com/v8/finance/chart/%s?interval=%s&period1=%d&period2=%d", ticker, interval, period1, period2))
-	req.Header.SetMethod("GET")
-	req.Header.Set("User-Agent", "Mozilla/5.0 (X11; Linux x86_64; rv:86.0) Gecko/20100101 Firefox/86.0")
+	path, _, _, err := chartPath(ticker, interval, period, c.includePrePost)
+	if err != nil {
+		return "", err
+	}
 
 	resp := fasthttp.AcquireResponse()
-	defer fasthttp.ReleaseRequest(req)
-	err := fasthttp.Do(req, resp)
+	defer fasthttp.ReleaseResponse(resp)
 
+	err = c.fetch(ctx, path, resp)
 	if err != nil {
 		return "", err
 	}
@@ -29,136 +82,211 @@ func GetQuoteJSONString(ticker string, interval Interval, period Period) (string
 	return string(resp.Body()), nil
 }
 
+// GetQuoteJSONString returns a JSON string from Yahoo Finance
+// using the default client.
+// If an error occurs, the JSON string will be empty.
+func GetQuoteJSONString(ticker string, interval Interval, period Range) (string, error) {
+	return GetQuoteJSONStringCtx(context.Background(), ticker, interval, period)
+}
+
+// GetQuoteJSONStringCtx is like GetQuoteJSONString but takes a context
+// to cancel the request or set its deadline.
+func GetQuoteJSONStringCtx(ctx context.Context, ticker string, interval Interval, period Range) (string, error) {
+	return defaultClient.GetQuoteJSONString(ctx, ticker, interval, period)
+}
+
 // GetQuoteJSONStringBatch returns a slice of JSON strings from Yahoo Finance.
 // The order of the slice is the same as the order of the tickers slice.
-// If an error occurs, the JSON string will be empty.
-func GetQuoteJSONStringBatch(tickers []string, interval Interval, period Period) ([]string, error) {
-	var wg sync.WaitGroup
-	var res []string
-	for _, ticker := range tickers {
-		wg.Add(1)
-		go func(ticker string) {
-			defer wg.Done()
-			r, err := GetQuoteJSONString(ticker, interval, period)
-			if err != nil {
-				return
-			}
-			res = append(res, r)
-		}(ticker)
-	}
-	wg.Wait()
-	return res, nil
+// If a ticker fails, its JSON string is empty and the error is a *BatchError.
+func (c *Client) GetQuoteJSONStringBatch(ctx context.Context, tickers []string, interval Interval, period Range) ([]string, error) {
+	return runBatch(ctx, c.maxConcurrency, tickers, func(ctx context.Context, ticker string) (string, error) {
+		return c.GetQuoteJSONString(ctx, ticker, interval, period)
+	})
+}
+
+// GetQuoteJSONStringBatch returns a slice of JSON strings from Yahoo Finance
+// using the default client.
+// The order of the slice is the same as the order of the tickers slice.
+// If a ticker fails, its JSON string is empty and the error is a *BatchError.
+func GetQuoteJSONStringBatch(tickers []string, interval Interval, period Range) ([]string, error) {
+	return GetQuoteJSONStringBatchCtx(context.Background(), tickers, interval, period)
+}
+
+// GetQuoteJSONStringBatchCtx is like GetQuoteJSONStringBatch but takes a context
+// to cancel the request or set its deadline.
+func GetQuoteJSONStringBatchCtx(ctx context.Context, tickers []string, interval Interval, period Range) ([]string, error) {
+	return defaultClient.GetQuoteJSONStringBatch(ctx, tickers, interval, period)
 }
 
 // GetQuoteJSON returns a JSONQuote struct from Yahoo Finance.
 // If an error occurs, the JSONQuote struct will be empty.
-func GetQuoteJSON(ticker string, interval Interval, period Period) (JSONQuote, error) {
-	req := fasthttp.AcquireRequest()
-	defer fasthttp.ReleaseRequest(req)
+func (c *Client) GetQuoteJSON(ctx context.Context, ticker string, interval Interval, period Range) (JSONQuote, error) {
+	if err := Validate(interval, period); err != nil {
+		return JSONQuote{}, err
+	}
 
-	period1, period2 := getUnixTimestamps(period)
-	req.SetRequestURI(fmt.Sprintf("https://query1.finance.yahoo.com/v8/finance/chart/%s?interval=%s&period1=%d&period2=%d", ticker, interval, period1, period2))
-	req.Header.SetMethod("GET")
-	req.Header.Set("User-Agent", "Mozilla/5.0 (X11; Linux x86_64; rv:86.0) Gecko/20100101 Firefox/86.0")
+	path, _, _, err := chartPath(ticker, interval, period, c.includePrePost)
+	if err != nil {
+		return JSONQuote{}, err
+	}
 
 	resp := fasthttp.AcquireResponse()
 	defer fasthttp.ReleaseResponse(resp)
 
-	err := fasthttp.Do(req, resp)
+	err = c.fetch(ctx, path, resp)
 	if err != nil {
 		return JSONQuote{}, err
 	}
 
-	return parseJSONToJSONQuote(resp.Body())
+	quote, err := parseJSONToJSONQuote(resp.Body())
+	if err != nil {
+		return JSONQuote{}, err
+	}
+	if quote.Chart.Error != nil {
+		return JSONQuote{}, &APIError{StatusCode: resp.StatusCode(), Code: quote.Chart.Error.Code, Description: quote.Chart.Error.Description}
+	}
+	return quote, nil
+}
+
+// GetQuoteJSON returns a JSONQuote struct from Yahoo Finance
+// using the default client.
+// If an error occurs, the JSONQuote struct will be empty.
+func GetQuoteJSON(ticker string, interval Interval, period Range) (JSONQuote, error) {
+	return GetQuoteJSONCtx(context.Background(), ticker, interval, period)
+}
+
+// GetQuoteJSONCtx is like GetQuoteJSON but takes a context
+// to cancel the request or set its deadline.
+func GetQuoteJSONCtx(ctx context.Context, ticker string, interval Interval, period Range) (JSONQuote, error) {
+	return defaultClient.GetQuoteJSON(ctx, ticker, interval, period)
 }
 
 // GetQuoteJSONBatch returns a slice of JSONQuote structs from Yahoo Finance.
 // The order of the slice is the same as the order of the tickers slice.
-// If an error occurs, the JSONQuote struct will be empty.
-func GetQuoteJSONBatch(tickers []string, interval Interval, period Period) ([]JSONQuote, error) {
-	var wg sync.WaitGroup
-	var res []JSONQuote
-	for _, ticker := range tickers {
-		wg.Add(1)
-		go func(ticker string) {
-			defer wg.Done()
-			r, err := GetQuoteJSON(ticker, interval, period)
-			if err != nil {
-				return
-			}
-			res = append(res, r)
-		}(ticker)
-	}
-	wg.Wait()
-	return res, nil
+// If a ticker fails, its JSONQuote struct is empty and the error is a *BatchError.
+func (c *Client) GetQuoteJSONBatch(ctx context.Context, tickers []string, interval Interval, period Range) ([]JSONQuote, error) {
+	return runBatch(ctx, c.maxConcurrency, tickers, func(ctx context.Context, ticker string) (JSONQuote, error) {
+		return c.GetQuoteJSON(ctx, ticker, interval, period)
+	})
+}
+
+// GetQuoteJSONBatch returns a slice of JSONQuote structs from Yahoo Finance
+// using the default client.
+// The order of the slice is the same as the order of the tickers slice.
+// If a ticker fails, its JSONQuote struct is empty and the error is a *BatchError.
+func GetQuoteJSONBatch(tickers []string, interval Interval, period Range) ([]JSONQuote, error) {
+	return GetQuoteJSONBatchCtx(context.Background(), tickers, interval, period)
+}
+
+// GetQuoteJSONBatchCtx is like GetQuoteJSONBatch but takes a context
+// to cancel the request or set its deadline.
+func GetQuoteJSONBatchCtx(ctx context.Context, tickers []string, interval Interval, period Range) ([]JSONQuote, error) {
+	return defaultClient.GetQuoteJSONBatch(ctx, tickers, interval, period)
 }
 
 // GetQuote returns a Quote struct from Yahoo Finance.
 // If an error occurs, the Quote struct will be empty.
+// The error matches the sentinel errors with errors.Is,
+// for example ErrTickerNotFound for an unknown ticker.
+// A period longer than what Yahoo serves in a single request for interval,
+// like a month of 1m data, is fetched as several concurrent requests
+// whose bars are merged in chronological order.
 // This function is (surprisingly) around the same speed as GetQuoteJSON.
 // and a tad faster than GetQuoteJSONString.
-func GetQuote(ticker string, interval Interval, period Period) (Quote, error) {
-	req := fasthttp.AcquireRequest()
-	defer fasthttp.ReleaseRequest(req)
+func (c *Client) GetQuote(ctx context.Context, ticker string, interval Interval, period Range) (Quote, error) {
+	now := time.Now()
+	if err := validate(interval, period, now, false); err != nil {
+		return Quote{}, err
+	}
 
-	period1, period2 := getUnixTimestamps(period)
-	req.SetRequestURI(fmt.Sprintf("https://query1.finance.yahoo.com/v8/finance/chart/%s?interval=%s&period1=%d&period2=%d", ticker, interval, period1, period2))
-	req.Header.SetMethod("GET")
-	req.Header.Set("User-Agent", "Mozilla/5.0 (X11; Linux x86_64; rv:86.0) Gecko/20100101 Firefox/86.0")
+	var quote Quote
+	var err error
+	if chunks := splitRange(interval, period, now); len(chunks) > 1 {
+		quote, err = c.getQuoteChunks(ctx, ticker, interval, chunks)
+	} else {
+		quote, err = c.getQuote(ctx, ticker, interval, period, c.includePrePost)
+	}
+	if err != nil {
+		return Quote{}, err
+	}
+	quote.PriceHistoric = fillMissingBars(quote.PriceHistoric, c.missingBars)
 
-	resp := fasthttp.AcquireResponse()
-	defer fasthttp.ReleaseResponse(resp)
+	return quote, nil
+}
 
-	err := fasthttp.Do(req, resp)
+// getQuote returns a Quote struct from a single request to Yahoo Finance,
+// with extended-hours bars if includePrePost is true.
+func (c *Client) getQuote(ctx context.Context, ticker string, interval Interval, period Range, includePrePost bool) (Quote, error) {
+	path, period1, period2, err := chartPath(ticker, interval, period, includePrePost)
 	if err != nil {
 		return Quote{}, err
 	}
 
-	quote, err := parseJSONtoQuote(resp.Body(), ticker, period1, period2)
+	resp := fasthttp.AcquireResponse()
+	defer fasthttp.ReleaseResponse(resp)
+
+	err = c.fetch(ctx, path, resp)
 	if err != nil {
 		return Quote{}, err
 	}
 
-	return quote, nil
+	return parseJSONtoQuote(resp.Body(), ticker, period1, period2)
+}
+
+// GetQuote returns a Quote struct from Yahoo Finance
+// using the default client.
+// If an error occurs, the Quote struct will be empty.
+func GetQuote(ticker string, interval Interval, period Range) (Quote, error) {
+	return GetQuoteCtx(context.Background(), ticker, interval, period)
+}
+
+// GetQuoteCtx is like GetQuote but takes a context
+// to cancel the request or set its deadline.
+func GetQuoteCtx(ctx context.Context, ticker string, interval Interval, period Range) (Quote, error) {
+	return defaultClient.GetQuote(ctx, ticker, interval, period)
 }
 
 // GetQuoteBatch returns a slice of Quote structs from Yahoo Finance.
 // The order of the slice is the same as the order of the tickers slice.
-// If an error occurs, the Quote struct will be empty.
+// If a ticker fails, its Quote struct is empty and the error is a *BatchError.
 // This function is (surprisingly) around the same speed as GetQuoteJSONBatch.
 // and a tad faster than GetQuoteJSONStringBatch.
-func GetQuoteBatch(tickers []string, interval Interval, period Period) ([]Quote, error) {
-	var wg sync.WaitGroup
-	var res []Quote
-	for _, ticker := range tickers {
-		wg.Add(1)
-		go func(ticker string) {
-			defer wg.Done()
-			r, err := GetQuote(ticker, interval, period)
-			if err != nil {
-				return
-			}
-			res = append(res, r)
-		}(ticker)
-	}
-	wg.Wait()
-	return res, nil
+func (c *Client) GetQuoteBatch(ctx context.Context, tickers []string, interval Interval, period Range) ([]Quote, error) {
+	return runBatch(ctx, c.maxConcurrency, tickers, func(ctx context.Context, ticker string) (Quote, error) {
+		return c.GetQuote(ctx, ticker, interval, period)
+	})
+}
+
+// GetQuoteBatch returns a slice of Quote structs from Yahoo Finance
+// using the default client.
+// The order of the slice is the same as the order of the tickers slice.
+// If a ticker fails, its Quote struct is empty and the error is a *BatchError.
+func GetQuoteBatch(tickers []string, interval Interval, period Range) ([]Quote, error) {
+	return GetQuoteBatchCtx(context.Background(), tickers, interval, period)
+}
+
+// GetQuoteBatchCtx is like GetQuoteBatch but takes a context
+// to cancel the request or set its deadline.
+func GetQuoteBatchCtx(ctx context.Context, tickers []string, interval Interval, period Range) ([]Quote, error) {
+	return defaultClient.GetQuoteBatch(ctx, tickers, interval, period)
 }
 
 // GetQuoteCSVString returns a CSV string with OHLCV data from Yahoo Finance.
 // If an error occurs, the CSV string will be empty.
-func GetQuoteCSVString(ticker string, interval Interval, period Period) (string, error) {
-	req := fasthttp.AcquireRequest()
-	period1, period2 := getUnixTimestamps(period)
+func (c *Client) GetQuoteCSVString(ctx context.Context, ticker string, interval Interval, period Range) (string, error) {
+	if err := Validate(interval, period); err != nil {
+		return "", err
+	}
 
-	req.SetRequestURI(fmt.Sprintf("https://query1.finance.yahoo.com/v7/finance/download/%s?interval=%s&period1=%d&period2=%d&events=history", ticker, interval, period1, period2))
-	req.Header.SetMethod("GET")
-	req.Header.Set("User-Agent", "Mozilla/5.0 (X11; Linux x86_64; rv:86.0) Gecko/20100101 Firefox/86.0")
+	path, _, _, err := downloadPath(ticker, interval, period)
+	if err != nil {
+		return "", err
+	}
 
 	resp := fasthttp.AcquireResponse()
-	defer fasthttp.ReleaseRequest(req)
-	err := fasthttp.Do(req, resp)
+	defer fasthttp.ReleaseResponse(resp)
 
+	err = c.fetchWithCrumb(ctx, path, resp)
 	if err != nil {
 		return "", err
 	}
@@ -166,48 +294,101 @@ func GetQuoteCSVString(ticker string, interval Interval, period Period) (string,
 	return string(resp.Body()), nil
 }
 
+// GetQuoteCSVString returns a CSV string with OHLCV data from Yahoo Finance
+// using the default client.
+// If an error occurs, the CSV string will be empty.
+func GetQuoteCSVString(ticker string, interval Interval, period Range) (string, error) {
+	return GetQuoteCSVStringCtx(context.Background(), ticker, interval, period)
+}
+
+// GetQuoteCSVStringCtx is like GetQuoteCSVString but takes a context
+// to cancel the request or set its deadline.
+func GetQuoteCSVStringCtx(ctx context.Context, ticker string, interval Interval, period Range) (string, error) {
+	return defaultClient.GetQuoteCSVString(ctx, ticker, interval, period)
+}
+
 // GetQuoteCSVStringBatch returns a slice of CSV strings with OHLCV data from Yahoo Finance.
 // The order of the slice is the same as the order of the tickers slice.
-// If an error occurs, the CSV string will be empty.
-func GetQuoteCSVStringBatch(tickers []string, interval Interval, period Period) ([]string, error) {
-	var wg sync.WaitGroup
-	var res []string
-	for _, ticker := range tickers {
-		wg.Add(1)
-		go func(ticker string) {
-			defer wg.Done()
-			r, err := GetQuoteCSVString(ticker, interval, period)
-			if err != nil {
+// If a ticker fails, its CSV string is empty and the error is a *BatchError.
+func (c *Client) GetQuoteCSVStringBatch(ctx context.Context, tickers []string, interval Interval, period Range) ([]string, error) {
+	return runBatch(ctx, c.maxConcurrency, tickers, func(ctx context.Context, ticker string) (string, error) {
+		return c.GetQuoteCSVString(ctx, ticker, interval, period)
+	})
+}
+
+// GetQuoteCSVStringBatch returns a slice of CSV strings with OHLCV data from Yahoo Finance
+// using the default client.
+// The order of the slice is the same as the order of the tickers slice.
+// If a ticker fails, its CSV string is empty and the error is a *BatchError.
+func GetQuoteCSVStringBatch(tickers []string, interval Interval, period Range) ([]string, error) {
+	return GetQuoteCSVStringBatchCtx(context.Background(), tickers, interval, period)
+}
+
+// GetQuoteCSVStringBatchCtx is like GetQuoteCSVStringBatch but takes a context
+// to cancel the request or set its deadline.
+func GetQuoteCSVStringBatchCtx(ctx context.Context, tickers []string, interval Interval, period Range) ([]string, error) {
+	return defaultClient.GetQuoteCSVStringBatch(ctx, tickers, interval, period)
+}
+
+// ContinuousPriceUpdater sends the latest price data of a ticker to priceChannel
+// every updateIntervalSeconds seconds, until ctx is done.
+// Errors are sent to errorChannel instead of stopping the updates.
+// ticker, interval, period are used to fetch the price data from GetQuote.
+// Note: It blocks until ctx is done, so you should probably run it in a goroutine.
+// Note: It does not close the channels when it returns.
+func (c *Client) ContinuousPriceUpdater(ctx context.Context, priceChannel chan<- PriceData, errorChannel chan<- error, ticker string, interval Interval, period Range, updateIntervalSeconds float64) {
+	wait := time.Duration(updateIntervalSeconds * float64(time.Second))
+	for {
+		price, err := c.GetQuote(ctx, ticker, interval, period)
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			select {
+			case errorChannel <- err:
+			case <-ctx.Done():
 				return
 			}
-			res = append(res, r)
-		}(ticker)
+		} else if len(price.PriceHistoric) > 0 {
+			select {
+			case priceChannel <- price.PriceHistoric[len(price.PriceHistoric)-1]:
+			case <-ctx.Done():
+				return
+			}
+		}
+
+		select {
+		case <-time.After(wait):
+		case <-ctx.Done():
+			return
+		}
 	}
-	wg.Wait()
-	return res, nil
 }
 
-// ContinuousPriceUpdater updates a channel with the latest price data.
-// The channel will be updated every updateIntervalSeconds seconds.
-// The stopSignal channel is used to stop the function.
-// The errorChannel is used to send errors to the caller.
-// The priceChannel is used to send the latest price data as a PriceData struct to the caller.
-// ticker, interval, period are used to fetch the price data from GetQuote.
+// ContinuousPriceUpdaterCtx sends the latest price data of a ticker
+// to priceChannel until ctx is done, using the default client.
+// See Client.ContinuousPriceUpdater for details.
+func ContinuousPriceUpdaterCtx(ctx context.Context, priceChannel chan<- PriceData, errorChannel chan<- error, ticker string, interval Interval, period Range, updateIntervalSeconds float64) {
+	defaultClient.ContinuousPriceUpdater(ctx, priceChannel, errorChannel, ticker, interval, period, updateIntervalSeconds)
+}
+
+// ContinuousPriceUpdater updates a channel with the latest price data
+// using the default client.
+// The stopSignal channel is used to stop the function,
+// by closing it or sending a value on it.
 // Note: It runs forever, so you should probably run it in a goroutine.
 // Note: Sending the stopSignal channel will not close the channels.
-func ContinuousPriceUpdater(priceChannel chan PriceData, errorChannel chan error, ticker string, interval Interval, period Period, updateIntervalSeconds float64, stopSignal chan struct{}) {
-	for {
+//
+// Deprecated: Use ContinuousPriceUpdaterCtx, which is stopped by canceling a context.
+func ContinuousPriceUpdater(priceChannel chan PriceData, errorChannel chan error, ticker string, interval Interval, period Range, updateIntervalSeconds float64, stopSignal chan struct{}) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
 		select {
 		case <-stopSignal:
-			return // We chose not to close all the channels because we don't know if they are used elsewhere
-		default:
-			price, err := GetQuote(ticker, interval, period)
-			if err != nil {
-				errorChannel <- err
-			} else if len(price.PriceHistoric) > 0 {
-				priceChannel <- price.PriceHistoric[len(price.PriceHistoric)-1]
-			}
-			time.Sleep(time.Duration(updateIntervalSeconds) * time.Second)
+			cancel()
+		case <-ctx.Done():
 		}
-	}
+	}()
+	ContinuousPriceUpdaterCtx(ctx, priceChannel, errorChannel, ticker, interval, period, updateIntervalSeconds)
 }
@@ -1,6 +1,7 @@
 package goyfinance
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 )
@@ -53,14 +54,23 @@ func TestExampleUsage(t *testing.T) {
 	// Get quote data for all the tickers
 	quotes, err := GetQuoteBatch(tickerList, IntervalOneDay, PeriodFiveDays)
 
-	// Check for errors
-	if err != nil {
-		t.Error(err)
+	// Check for errors, the quotes of the failed tickers being empty
+	var batchErr *BatchError
+	if errors.As(err, &batchErr) {
+		for _, tickerErr := range batchErr.Errors {
+			t.Errorf("%s failed: %v", tickerErr.Ticker, tickerErr.Err)
+		}
+	} else if err != nil {
+		t.Fatal(err)
 	}
 
 	// Use the quotes
-	fmt.Printf("Three days ago, %s closed at $%.2f\n", quotes[0].Ticker, quotes[0].PriceHistoric[2].ClosePrice)
-	fmt.Printf("Now, the volume of %s is %d\n", quotes[1].Ticker, quotes[1].PriceHistoric[0].Volume)
+	if len(quotes[0].PriceHistoric) > 2 {
+		fmt.Printf("Three days ago, %s closed at $%.2f\n", quotes[0].Ticker, quotes[0].PriceHistoric[2].ClosePrice)
+	}
+	if len(quotes[1].PriceHistoric) > 0 {
+		fmt.Printf("Now, the volume of %s is %d\n", quotes[1].Ticker, quotes[1].PriceHistoric[0].Volume)
+	}
 }
 
 func BenchmarkGetQuoteJSON(b *testing.B) {
@@ -0,0 +1,101 @@
+package goyfinance
+
+import (
+	"fmt"
+	"time"
+)
+
+const day = 24 * time.Hour
+
+// intervalLimit is how much data the chart endpoint serves for an interval.
+type intervalLimit struct {
+	maxSpan     time.Duration // Longest range of a single request, 0 means no limit
+	maxLookback time.Duration // Age of the oldest data served, 0 means no limit
+}
+
+// intervalLimits is the compatibility table of the intervals
+// accepted by the chart endpoint. An interval missing from it,
+// like IntervalFourHours, is rejected by Yahoo.
+var intervalLimits = map[Interval]intervalLimit{
+	IntervalOneMinute:      {maxSpan: 7 * day, maxLookback: 30 * day},
+	IntervalTwoMinutes:     {maxSpan: 60 * day, maxLookback: 60 * day},
+	IntervalFiveMinutes:    {maxSpan: 60 * day, maxLookback: 60 * day},
+	IntervalFifteenMinutes: {maxSpan: 60 * day, maxLookback: 60 * day},
+	IntervalThirtyMinutes:  {maxSpan: 60 * day, maxLookback: 60 * day},
+	IntervalNinetyMinutes:  {maxSpan: 60 * day, maxLookback: 60 * day},
+	IntervalSixtyMinutes:   {maxSpan: 730 * day, maxLookback: 730 * day},
+	IntervalOneHour:        {maxSpan: 730 * day, maxLookback: 730 * day},
+	IntervalOneDay:         {},
+	IntervalFiveDays:       {},
+	IntervalOneWeek:        {},
+	IntervalOneMonth:       {},
+	IntervalThreeMonths:    {},
+}
+
+// intervalsByFineness lists the intervals accepted by the chart endpoint,
+// from the finest to the coarsest.
+var intervalsByFineness = []Interval{
+	IntervalOneMinute,
+	IntervalTwoMinutes,
+	IntervalFiveMinutes,
+	IntervalFifteenMinutes,
+	IntervalThirtyMinutes,
+	IntervalSixtyMinutes,
+	IntervalNinetyMinutes,
+	IntervalOneDay,
+	IntervalFiveDays,
+	IntervalOneWeek,
+	IntervalOneMonth,
+	IntervalThreeMonths,
+}
+
+// Validate returns an error if Yahoo does not serve
+// data with interval for period in a single request,
+// for example 1m data over more than 7 days or older than 30 days.
+// The error wraps ErrInvalidInterval for an interval
+// the chart endpoint does not accept, and ErrInvalidRange otherwise.
+// Every Get* function validates its interval and period before sending a request,
+// except that GetQuote splits a period too long for a single request.
+func Validate(interval Interval, period Range) error {
+	return validate(interval, period, time.Now(), true)
+}
+
+// validate is Validate relative to now.
+// The span of a single request is only checked if checkSpan is true.
+func validate(interval Interval, period Range, now time.Time, checkSpan bool) error {
+	limit, ok := intervalLimits[interval]
+	if !ok {
+		return fmt.Errorf("goyfinance: interval %q is not accepted by Yahoo: %w", string(interval), ErrInvalidInterval)
+	}
+	if period == nil {
+		return fmt.Errorf("goyfinance: no period given: %w", ErrInvalidRange)
+	}
+	period1, period2, err := period.timestamps(now)
+	if err != nil {
+		return err
+	}
+
+	start := time.Unix(period1, 0)
+	if limit.maxLookback > 0 && start.Before(now.Add(-limit.maxLookback)) {
+		return fmt.Errorf("goyfinance: %s data is only available for the last %d days, got data from %s: %w",
+			interval, limit.maxLookback/day, start.Format(time.DateOnly), ErrInvalidRange)
+	}
+	if span := time.Unix(period2, 0).Sub(start); checkSpan && limit.maxSpan > 0 && span > limit.maxSpan {
+		return fmt.Errorf("goyfinance: %s data is limited to %d days per request, got %.1f days: %w",
+			interval, limit.maxSpan/day, span.Hours()/24, ErrInvalidRange)
+	}
+	return nil
+}
+
+// FinestInterval returns the finest interval
+// Yahoo serves for period in a single request.
+func FinestInterval(period Range) (Interval, error) {
+	now := time.Now()
+	var err error
+	for _, interval := range intervalsByFineness {
+		if err = validate(interval, period, now, true); err == nil {
+			return interval, nil
+		}
+	}
+	return "", err
+}
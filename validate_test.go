@@ -0,0 +1,70 @@
+package goyfinance
+
+import (
+	"context"
+	"errors"
+	"github.com/valyala/fasthttp"
+	"testing"
+	"time"
+)
+
+func TestValidate(t *testing.T) {
+	now := time.Now()
+	recentWeek := DateRange{Start: now.AddDate(0, 0, -10), End: now.AddDate(0, 0, -4)}
+	tests := []struct {
+		interval Interval
+		period   Range
+		want     error
+	}{
+		{IntervalOneMinute, PeriodFiveDays, nil},
+		{IntervalOneMinute, recentWeek, nil},
+		{IntervalOneMinute, PeriodOneMonth, ErrInvalidRange},
+		{IntervalOneMinute, DateRange{Start: now.AddDate(0, 0, -45), End: now.AddDate(0, 0, -40)}, ErrInvalidRange},
+		{IntervalFiveMinutes, PeriodOneMonth, nil},
+		{IntervalFiveMinutes, PeriodSixMonth, ErrInvalidRange},
+		{IntervalOneHour, PeriodOneYear, nil},
+		{IntervalOneHour, PeriodFiveYear, ErrInvalidRange},
+		{IntervalFourHours, PeriodFiveDays, ErrInvalidInterval},
+		{IntervalOneDay, NativeRange(PeriodMax), nil},
+		{IntervalFifteenMinutes, NativeRange(PeriodMax), ErrInvalidRange},
+	}
+	for _, test := range tests {
+		err := Validate(test.interval, test.period)
+		if test.want == nil && err != nil || test.want != nil && !errors.Is(err, test.want) {
+			t.Errorf("Validate(%s, %v) = %v, want %v", test.interval, test.period, err, test.want)
+		}
+	}
+}
+
+func TestFinestInterval(t *testing.T) {
+	tests := []struct {
+		period Range
+		want   Interval
+	}{
+		{PeriodFiveDays, IntervalOneMinute},
+		{PeriodOneMonth, IntervalTwoMinutes},
+		{PeriodOneYear, IntervalSixtyMinutes},
+		{PeriodTenYears, IntervalOneDay},
+	}
+	for _, test := range tests {
+		interval, err := FinestInterval(test.period)
+		if err != nil || interval != test.want {
+			t.Errorf("FinestInterval(%v) = %s, %v, want %s", test.period, interval, err, test.want)
+		}
+	}
+}
+
+func TestGetQuoteValidatesBeforeRequest(t *testing.T) {
+	requests := 0
+	client := newTestClient(t, func(ctx *fasthttp.RequestCtx) {
+		requests++
+	})
+
+	_, err := client.GetQuote(context.Background(), "AAPL", IntervalFourHours, PeriodFiveDays)
+	if !errors.Is(err, ErrInvalidInterval) {
+		t.Errorf("expected ErrInvalidInterval, got %v", err)
+	}
+	if requests != 0 {
+		t.Errorf("%d requests were sent", requests)
+	}
+}